@@ -0,0 +1,104 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestApplicationRoleService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/applicationrole", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/3/applicationrole")
+		fmt.Fprint(w, `[{"key":"jira-software","groups":["jira-software-users"],"groupDetails":[{"name":"jira-software-users","groupId":"g-1"}],
+			"name":"Jira Software","defaultGroups":["jira-software-users"],"defaultGroupsDetails":[{"name":"jira-software-users","groupId":"g-1"}],
+			"selectedByDefault":false,"defined":true,"numberOfSeats":10,"remainingSeats":5,"userCount":5,"userCountDescription":"users",
+			"hasUnlimitedSeats":false,"platform":false}]`)
+	})
+
+	roles, _, err := testClient.ApplicationRole.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(roles) != 1 {
+		t.Fatalf("expected 1 role, got %d", len(roles))
+	}
+	role := roles[0]
+	if role.Key != "jira-software" || role.NumberOfSeats != 10 || role.RemainingSeats != 5 {
+		t.Errorf("unexpected role %+v", role)
+	}
+	want := []GroupName{{Name: "jira-software-users", GroupID: "g-1"}}
+	if !reflect.DeepEqual(role.GroupDetails, want) || !reflect.DeepEqual(role.DefaultGroupsDetails, want) {
+		t.Errorf("unexpected group details %+v, %+v", role.GroupDetails, role.DefaultGroupsDetails)
+	}
+}
+
+func TestApplicationRoleService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/applicationrole/jira-software", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"key":"jira-software","name":"Jira Software","hasUnlimitedSeats":true}`)
+	})
+
+	role, _, err := testClient.ApplicationRole.Get(context.Background(), "jira-software")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if role.Name != "Jira Software" || !role.HasUnlimitedSeats {
+		t.Errorf("unexpected role %+v", role)
+	}
+}
+
+func TestApplicationRoleService_LicenseUtilization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/applicationrole", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"key":"jira-software","name":"Jira Software","numberOfSeats":4,"remainingSeats":1,"userCount":3,
+				"groupDetails":[{"name":"developers","groupId":"g-dev"},{"name":"admins","groupId":"g-admin"}]},
+			{"key":"jira-servicedesk","name":"Jira Service Management","hasUnlimitedSeats":true,
+				"groupDetails":[{"name":"admins","groupId":"g-admin"}]}]`)
+	})
+	groupRequests := map[string]int{}
+	testMux.HandleFunc("/rest/api/3/group/member", func(w http.ResponseWriter, r *http.Request) {
+		groupID := r.URL.Query().Get("groupId")
+		groupRequests[groupID]++
+		switch groupID {
+		case "g-dev":
+			fmt.Fprint(w, `{"isLast":true,"values":[
+				{"accountId":"alice","active":true,"accountType":"atlassian"},
+				{"accountId":"bot","active":true,"accountType":"app"},
+				{"accountId":"carol","active":false,"accountType":"atlassian"}]}`)
+		case "g-admin":
+			fmt.Fprint(w, `{"isLast":true,"values":[
+				{"accountId":"alice","active":true,"accountType":"atlassian"},
+				{"accountId":"bob","active":true,"accountType":"atlassian"}]}`)
+		default:
+			t.Errorf("unexpected group %q", groupID)
+		}
+	})
+
+	report, _, err := testClient.ApplicationRole.LicenseUtilization(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(report) != 2 {
+		t.Fatalf("expected 2 roles, got %d", len(report))
+	}
+	software := report[0]
+	if !reflect.DeepEqual(software.Users, []string{"alice", "bob"}) || software.BillableUsers() != 2 || software.Utilization() != 0.5 {
+		t.Errorf("unexpected utilization %+v", software)
+	}
+	serviceDesk := report[1]
+	if serviceDesk.BillableUsers() != 2 || serviceDesk.Utilization() != 0 {
+		t.Errorf("unexpected utilization %+v", serviceDesk)
+	}
+	if groupRequests["g-admin"] != 1 {
+		t.Errorf("expected the members of a group to be listed once, got %d requests", groupRequests["g-admin"])
+	}
+}
@@ -0,0 +1,318 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrAttachmentTooLarge is returned by DownloadAttachmentTo if the attachment
+	// exceeds DownloadAttachmentOptions.MaxSize.
+	ErrAttachmentTooLarge = errors.New("attachment exceeds the maximum allowed size")
+
+	// ErrAttachmentMimeType is returned by DownloadAttachmentTo if the MIME type
+	// of the attachment is not listed in DownloadAttachmentOptions.AllowedMimeTypes.
+	ErrAttachmentMimeType = errors.New("attachment MIME type is not allowed")
+)
+
+// AttachmentFile is a single file uploaded by PostAttachments.
+type AttachmentFile struct {
+	// Name is the file name the attachment is stored under.
+	Name string
+
+	// Reader provides the content of the file.
+	// A nil Reader uploads an empty file.
+	Reader io.Reader
+
+	// Size is the length of the content in bytes.
+	// If zero, the size is taken from Reader if it has a Len() method
+	// (like bytes.Reader or strings.Reader), otherwise it is unknown.
+	Size int64
+}
+
+// ProgressFunc is called while attachment content is transferred.
+// written is the number of content bytes transferred so far,
+// total is the expected number of content bytes or -1 if unknown.
+type ProgressFunc func(written, total int64)
+
+// PostAttachmentOptions specifies the optional parameters for PostAttachments.
+type PostAttachmentOptions struct {
+	// Progress is called after each chunk of file content has been sent.
+	Progress ProgressFunc
+}
+
+// DownloadAttachmentOptions specifies the optional parameters for DownloadAttachmentTo.
+type DownloadAttachmentOptions struct {
+	// MaxSize is the maximum number of bytes to download.
+	// Zero means no limit.
+	MaxSize int64
+
+	// AllowedMimeTypes restricts the download to attachments of the given MIME types.
+	// Entries can be exact ("application/pdf") or wildcards ("image/*").
+	// An empty list allows all MIME types.
+	AllowedMimeTypes []string
+
+	// Progress is called after each chunk of content has been written to w.
+	Progress ProgressFunc
+}
+
+// GetAttachment returns the metadata of an attachment for a given attachmentID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-attachment-id-get
+func (s *IssueService) GetAttachment(ctx context.Context, attachmentID string) (*Attachment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/attachment/%s", attachmentID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attachment := new(Attachment)
+	resp, err := s.client.Do(req, attachment)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return attachment, resp, nil
+}
+
+// DownloadAttachmentTo streams the content of an attachment for a given attachmentID into w.
+// The metadata of the attachment is fetched first and checked against options,
+// so that oversized attachments or unexpected MIME types are rejected before any content is transferred.
+// The size limit is enforced on the transferred content as well.
+//
+// The returned Response belongs to the content request, its body is already closed.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-attachment-content-id-get
+func (s *IssueService) DownloadAttachmentTo(ctx context.Context, attachmentID string, w io.Writer, options *DownloadAttachmentOptions) (*Attachment, *Response, error) {
+	if options == nil {
+		options = &DownloadAttachmentOptions{}
+	}
+
+	attachment, resp, err := s.GetAttachment(ctx, attachmentID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if options.MaxSize > 0 && int64(attachment.Size) > options.MaxSize {
+		return attachment, resp, fmt.Errorf("%w: attachment %s has %d bytes, limit is %d", ErrAttachmentTooLarge, attachmentID, attachment.Size, options.MaxSize)
+	}
+	if !mimeTypeAllowed(attachment.MimeType, options.AllowedMimeTypes) {
+		return attachment, resp, fmt.Errorf("%w: attachment %s is %q", ErrAttachmentMimeType, attachmentID, attachment.MimeType)
+	}
+
+	resp, err = s.DownloadAttachment(ctx, attachmentID)
+	if err != nil {
+		return attachment, resp, err
+	}
+	defer resp.Body.Close()
+
+	var body io.Reader = resp.Body
+	if options.MaxSize > 0 {
+		// Read one byte more than allowed to detect content exceeding the limit
+		body = io.LimitReader(resp.Body, options.MaxSize+1)
+	}
+	if options.Progress != nil {
+		w = &progressWriter{w: w, total: int64(attachment.Size), fn: options.Progress}
+	}
+
+	n, err := io.Copy(w, body)
+	if err != nil {
+		return attachment, resp, err
+	}
+	if options.MaxSize > 0 && n > options.MaxSize {
+		return attachment, resp, fmt.Errorf("%w: attachment %s content exceeds limit of %d bytes", ErrAttachmentTooLarge, attachmentID, options.MaxSize)
+	}
+
+	return attachment, resp, nil
+}
+
+// PostAttachments uploads one or more files as attachments to a given issueID.
+//
+// The files are streamed to Jira without buffering them in memory.
+// If the size of every file is known, the request is sent with a Content-Length header.
+// Otherwise chunked transfer encoding is used.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-issue-issueidorkey-attachments-post
+func (s *IssueService) PostAttachments(ctx context.Context, issueID string, files []AttachmentFile, options *PostAttachmentOptions) (*[]Attachment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/attachments", issueID)
+
+	if len(files) == 0 {
+		return nil, nil, errors.New("no attachment files given")
+	}
+
+	files = append([]AttachmentFile(nil), files...)
+	total := int64(0)
+	for i := range files {
+		files[i].Size = attachmentFileSize(files[i])
+		if total >= 0 && files[i].Size >= 0 {
+			total += files[i].Size
+		} else {
+			total = -1
+		}
+	}
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	req, err := s.client.NewMultiPartRequest(ctx, http.MethodPost, apiEndpoint, pr)
+	if err != nil {
+		pr.Close()
+		return nil, nil, err
+	}
+
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.ContentLength = -1
+	if total >= 0 {
+		overhead, err := multipartOverhead(writer.Boundary(), files)
+		if err != nil {
+			pr.Close()
+			return nil, nil, err
+		}
+		req.ContentLength = overhead + total
+	}
+
+	var progress ProgressFunc
+	if options != nil {
+		progress = options.Progress
+	}
+	go func() {
+		pw.CloseWithError(writeAttachmentFiles(writer, files, total, progress))
+	}()
+
+	// PostAttachment response returns a JSON array (as multiple attachments can be posted)
+	attachment := new([]Attachment)
+	resp, err := s.client.Do(req, attachment)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return attachment, resp, nil
+}
+
+// attachmentFileSize returns the size of f or -1 if it is unknown.
+func attachmentFileSize(f AttachmentFile) int64 {
+	if f.Reader == nil {
+		return 0
+	}
+	if f.Size > 0 {
+		return f.Size
+	}
+	if l, ok := f.Reader.(interface{ Len() int }); ok {
+		return int64(l.Len())
+	}
+	return -1
+}
+
+// multipartOverhead returns the number of bytes the multipart encoding
+// of files adds on top of the file contents.
+func multipartOverhead(boundary string, files []AttachmentFile) (int64, error) {
+	cw := &countingWriter{}
+	writer := multipart.NewWriter(cw)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return 0, err
+	}
+	for _, f := range files {
+		if _, err := writer.CreateFormFile("file", f.Name); err != nil {
+			return 0, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return 0, err
+	}
+	return cw.n, nil
+}
+
+// writeAttachmentFiles writes files as multipart form to writer.
+// If total is not negative, every file must provide exactly its declared size.
+func writeAttachmentFiles(writer *multipart.Writer, files []AttachmentFile, total int64, progress ProgressFunc) error {
+	var written int64
+	for _, f := range files {
+		fw, err := writer.CreateFormFile("file", f.Name)
+		if err != nil {
+			return err
+		}
+		if f.Reader == nil {
+			continue
+		}
+
+		var w io.Writer = fw
+		if progress != nil {
+			w = &progressWriter{w: fw, written: written, total: total, fn: progress}
+		}
+
+		var n int64
+		if total >= 0 {
+			n, err = io.CopyN(w, f.Reader, f.Size)
+			if err == io.EOF {
+				return fmt.Errorf("attachment %q is shorter than its declared size of %d bytes", f.Name, f.Size)
+			}
+			if err != nil {
+				return err
+			}
+			if extra, _ := f.Reader.Read(make([]byte, 1)); extra > 0 {
+				return fmt.Errorf("attachment %q is larger than its declared size of %d bytes", f.Name, f.Size)
+			}
+		} else {
+			n, err = io.Copy(w, f.Reader)
+			if err != nil {
+				return err
+			}
+		}
+		written += n
+	}
+	return writer.Close()
+}
+
+// mimeTypeAllowed reports whether mimeType matches one of allowed.
+// An empty allowed list matches every MIME type.
+func mimeTypeAllowed(mimeType string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	if mediaType, _, err := mime.ParseMediaType(mimeType); err == nil {
+		mimeType = mediaType
+	}
+	mimeType = strings.ToLower(mimeType)
+	for _, a := range allowed {
+		a = strings.ToLower(a)
+		if a == mimeType || a == "*/*" {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(a, "/*"); ok && strings.HasPrefix(mimeType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// progressWriter reports the number of bytes written to w to fn.
+type progressWriter struct {
+	w       io.Writer
+	written int64
+	total   int64
+	fn      ProgressFunc
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	p.fn(p.written, p.total)
+	return n, err
+}
+
+// countingWriter discards everything written to it and counts the bytes.
+type countingWriter struct {
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	c.n += int64(len(b))
+	return len(b), nil
+}
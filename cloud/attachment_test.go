@@ -0,0 +1,238 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIssueService_PostAttachments(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		if got := r.Header.Get("X-Atlassian-Token"); got != "nocheck" {
+			t.Errorf("X-Atlassian-Token: %q, want %q", got, "nocheck")
+		}
+		if r.ContentLength <= 0 {
+			t.Errorf("Expected a Content-Length. Got %d", r.ContentLength)
+		}
+
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Error parsing multipart form: %s", err)
+		}
+		files := r.MultipartForm.File["file"]
+		if len(files) != 2 {
+			t.Fatalf("Expected 2 files. Got %d", len(files))
+		}
+		for i, want := range []string{"build.log", "report.txt"} {
+			if files[i].Filename != want {
+				t.Errorf("Filename: %q, want %q", files[i].Filename, want)
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `[{"id":"1","filename":"build.log"},{"id":"2","filename":"report.txt"}]`)
+	})
+
+	files := []AttachmentFile{
+		{Name: "build.log", Reader: strings.NewReader("line 1\nline 2\n")},
+		{Name: "report.txt", Reader: bytes.NewBufferString("all good"), Size: 8},
+	}
+	var lastWritten, lastTotal int64
+	options := &PostAttachmentOptions{
+		Progress: func(written, total int64) {
+			lastWritten, lastTotal = written, total
+		},
+	}
+
+	attachments, _, err := testClient.Issue.PostAttachments(context.Background(), "10000", files, options)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(*attachments) != 2 {
+		t.Errorf("Expected 2 attachments. Got %d", len(*attachments))
+	}
+	if lastWritten != 22 || lastTotal != 22 {
+		t.Errorf("Expected progress 22/22. Got %d/%d", lastWritten, lastTotal)
+	}
+}
+
+func TestIssueService_PostAttachments_UnknownSize(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		if r.ContentLength != -1 {
+			t.Errorf("Expected chunked request. Got Content-Length %d", r.ContentLength)
+		}
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Error reading form file: %s", err)
+		}
+		defer file.Close()
+		data, _ := io.ReadAll(file)
+		if string(data) != "streamed content" {
+			t.Errorf("File content: %q", data)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `[{"id":"1","filename":"stream.txt"}]`)
+	})
+
+	// io.MultiReader hides the length of the underlying reader
+	reader := io.MultiReader(strings.NewReader("streamed "), strings.NewReader("content"))
+	files := []AttachmentFile{{Name: "stream.txt", Reader: reader}}
+
+	_, _, err := testClient.Issue.PostAttachments(context.Background(), "10000", files, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_PostAttachments_SizeMismatch(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `[]`)
+	})
+
+	files := []AttachmentFile{{Name: "short.txt", Reader: io.MultiReader(strings.NewReader("abc")), Size: 10}}
+
+	_, _, err := testClient.Issue.PostAttachments(context.Background(), "10000", files, nil)
+	if err == nil {
+		t.Error("Expected an error. Got none")
+	}
+}
+
+func TestIssueService_PostAttachments_NoFiles(t *testing.T) {
+	_, _, err := (&IssueService{}).PostAttachments(context.Background(), "10000", nil, nil)
+	if err == nil {
+		t.Error("Expected an error. Got none")
+	}
+}
+
+func TestIssueService_GetAttachment(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/attachment/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/attachment/10000")
+
+		fmt.Fprint(w, `{"id":"10000","filename":"picture.jpg","size":23123,"mimeType":"image/jpeg"}`)
+	})
+
+	attachment, _, err := testClient.Issue.GetAttachment(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if attachment.Filename != "picture.jpg" || attachment.Size != 23123 || attachment.MimeType != "image/jpeg" {
+		t.Errorf("Unexpected attachment: %+v", attachment)
+	}
+}
+
+func setupDownloadAttachment(t *testing.T, metadata, content string) {
+	testMux.HandleFunc("/rest/api/2/attachment/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, metadata)
+	})
+	testMux.HandleFunc("/rest/api/2/attachment/content/10000/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, content)
+	})
+}
+
+func TestIssueService_DownloadAttachmentTo(t *testing.T) {
+	setup()
+	defer teardown()
+	setupDownloadAttachment(t, `{"id":"10000","filename":"build.log","size":13,"mimeType":"text/plain; charset=UTF-8"}`, "Build passed.")
+
+	var buf bytes.Buffer
+	var lastWritten int64
+	options := &DownloadAttachmentOptions{
+		MaxSize:          100,
+		AllowedMimeTypes: []string{"text/*"},
+		Progress: func(written, total int64) {
+			lastWritten = written
+		},
+	}
+	attachment, _, err := testClient.Issue.DownloadAttachmentTo(context.Background(), "10000", &buf, options)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if attachment.Filename != "build.log" {
+		t.Errorf("Filename: %q, want %q", attachment.Filename, "build.log")
+	}
+	if buf.String() != "Build passed." {
+		t.Errorf("Content: %q", buf.String())
+	}
+	if lastWritten != 13 {
+		t.Errorf("Expected progress of 13 bytes. Got %d", lastWritten)
+	}
+}
+
+func TestIssueService_DownloadAttachmentTo_TooLarge(t *testing.T) {
+	setup()
+	defer teardown()
+	setupDownloadAttachment(t, `{"id":"10000","size":5000,"mimeType":"text/plain"}`, "")
+
+	_, _, err := testClient.Issue.DownloadAttachmentTo(context.Background(), "10000", io.Discard, &DownloadAttachmentOptions{MaxSize: 100})
+	if !errors.Is(err, ErrAttachmentTooLarge) {
+		t.Errorf("Expected ErrAttachmentTooLarge. Got %v", err)
+	}
+}
+
+func TestIssueService_DownloadAttachmentTo_ContentTooLarge(t *testing.T) {
+	setup()
+	defer teardown()
+	// The metadata claims a small attachment, the content is larger
+	setupDownloadAttachment(t, `{"id":"10000","size":5,"mimeType":"text/plain"}`, "0123456789")
+
+	var buf bytes.Buffer
+	_, _, err := testClient.Issue.DownloadAttachmentTo(context.Background(), "10000", &buf, &DownloadAttachmentOptions{MaxSize: 5})
+	if !errors.Is(err, ErrAttachmentTooLarge) {
+		t.Errorf("Expected ErrAttachmentTooLarge. Got %v", err)
+	}
+}
+
+func TestIssueService_DownloadAttachmentTo_MimeType(t *testing.T) {
+	setup()
+	defer teardown()
+	setupDownloadAttachment(t, `{"id":"10000","size":5,"mimeType":"application/x-msdownload"}`, "MZ...")
+
+	_, _, err := testClient.Issue.DownloadAttachmentTo(context.Background(), "10000", io.Discard, &DownloadAttachmentOptions{AllowedMimeTypes: []string{"image/*", "application/pdf"}})
+	if !errors.Is(err, ErrAttachmentMimeType) {
+		t.Errorf("Expected ErrAttachmentMimeType. Got %v", err)
+	}
+}
+
+func TestMimeTypeAllowed(t *testing.T) {
+	tests := []struct {
+		mimeType string
+		allowed  []string
+		want     bool
+	}{
+		{"image/png", nil, true},
+		{"image/png", []string{"image/png"}, true},
+		{"image/png", []string{"image/*"}, true},
+		{"IMAGE/PNG", []string{"image/png"}, true},
+		{"text/plain; charset=UTF-8", []string{"text/plain"}, true},
+		{"application/pdf", []string{"*/*"}, true},
+		{"application/pdf", []string{"image/*"}, false},
+		{"imagefoo/png", []string{"image/*"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := mimeTypeAllowed(tt.mimeType, tt.allowed); got != tt.want {
+			t.Errorf("mimeTypeAllowed(%q, %v) = %t, want %t", tt.mimeType, tt.allowed, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrCredentialNotFound is returned (wrapped) by a CredentialSource if it has no credential.
+var ErrCredentialNotFound = errors.New("credential not found")
+
+// Credential is a secret used by the auth transports.
+type Credential struct {
+	// Username is the username of the BasicAuthTransport or the issuer of the JWTAuthTransport.
+	// If empty, the Username or Issuer field of the transport is used.
+	Username string
+
+	// Secret is the API token, password or shared secret.
+	Secret string
+
+	// Expiry is the time after which the credential is fetched again from its source.
+	// A zero Expiry means the credential is cached until Jira rejects it.
+	Expiry time.Time
+}
+
+// CredentialSource provides the credential of an auth transport.
+// Transports with a CredentialSource query it before a request if they have no valid credential cached.
+// If Jira answers with 401 Unauthorized, the cached credential is dropped and the request is retried once
+// with a fresh credential. This allows to rotate credentials without creating a new client.
+//
+// Implementations must be safe for concurrent use.
+type CredentialSource interface {
+	Credential(ctx context.Context) (*Credential, error)
+}
+
+// CredentialFunc is an adapter to use an ordinary function as a CredentialSource,
+// e.g. to fetch credentials from a secret manager.
+type CredentialFunc func(ctx context.Context) (*Credential, error)
+
+// Credential implements the CredentialSource interface.
+func (f CredentialFunc) Credential(ctx context.Context) (*Credential, error) {
+	return f(ctx)
+}
+
+// EnvCredentials reads the credential from environment variables.
+type EnvCredentials struct {
+	// UsernameVar is the name of the variable holding the username. Optional.
+	UsernameVar string
+
+	// SecretVar is the name of the variable holding the secret.
+	SecretVar string
+}
+
+// Credential implements the CredentialSource interface.
+func (s *EnvCredentials) Credential(ctx context.Context) (*Credential, error) {
+	secret := os.Getenv(s.SecretVar)
+	if secret == "" {
+		return nil, fmt.Errorf("%w: environment variable %s is not set", ErrCredentialNotFound, s.SecretVar)
+	}
+
+	cred := &Credential{Secret: secret}
+	if s.UsernameVar != "" {
+		cred.Username = os.Getenv(s.UsernameVar)
+	}
+	return cred, nil
+}
+
+// FileCredentials reads the secret from a file, like a secret mounted into a container.
+// Leading and trailing white space is removed.
+type FileCredentials struct {
+	// Username is the username to use with the secret. Optional.
+	Username string
+
+	// Path is the file holding the secret.
+	Path string
+
+	// MaxAge is the time the secret is cached before the file is read again.
+	// If zero, the file is read again only if Jira rejects the secret.
+	MaxAge time.Duration
+}
+
+// Credential implements the CredentialSource interface.
+func (s *FileCredentials) Credential(ctx context.Context) (*Credential, error) {
+	b, err := os.ReadFile(s.Path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrCredentialNotFound, err)
+		}
+		return nil, err
+	}
+
+	secret := strings.TrimSpace(string(b))
+	if secret == "" {
+		return nil, fmt.Errorf("%w: %s is empty", ErrCredentialNotFound, s.Path)
+	}
+	return &Credential{Username: s.Username, Secret: secret, Expiry: expiry(s.MaxAge)}, nil
+}
+
+// NetrcCredentials reads the login and password of a machine from a .netrc file.
+type NetrcCredentials struct {
+	// Machine is the host name of the Jira instance, like "your-domain.atlassian.net".
+	// If there is no entry for it, the "default" entry is used.
+	Machine string
+
+	// Path is the location of the .netrc file.
+	// It defaults to the NETRC environment variable or .netrc in the home directory.
+	Path string
+
+	// MaxAge is the time the credential is cached before the file is read again.
+	// If zero, the file is read again only if Jira rejects the credential.
+	MaxAge time.Duration
+}
+
+// Credential implements the CredentialSource interface.
+func (s *NetrcCredentials) Credential(ctx context.Context) (*Credential, error) {
+	path := s.Path
+	if path == "" {
+		path = os.Getenv("NETRC")
+	}
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(home, ".netrc")
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrCredentialNotFound, err)
+		}
+		return nil, err
+	}
+
+	login, password, ok := parseNetrc(string(b), s.Machine)
+	if !ok {
+		return nil, fmt.Errorf("%w: no entry for machine %s in %s", ErrCredentialNotFound, s.Machine, path)
+	}
+	return &Credential{Username: login, Secret: password, Expiry: expiry(s.MaxAge)}, nil
+}
+
+// parseNetrc returns the login and password of machine, falling back to the default entry.
+func parseNetrc(data, machine string) (login, password string, ok bool) {
+	type entry struct{ login, password string }
+	var (
+		found, fallback *entry
+		current         *entry
+	)
+
+	lines := strings.Split(data, "\n")
+	for i := 0; i < len(lines); i++ {
+		fields := strings.Fields(lines[i])
+		for j := 0; j < len(fields); j++ {
+			switch fields[j] {
+			case "machine":
+				current = nil
+				if j+1 < len(fields) {
+					j++
+					if fields[j] == machine && found == nil {
+						found = &entry{}
+						current = found
+					}
+				}
+			case "default":
+				current = nil
+				if fallback == nil {
+					fallback = &entry{}
+					current = fallback
+				}
+			case "login", "password", "account":
+				if j+1 >= len(fields) {
+					continue
+				}
+				j++
+				if current == nil {
+					continue
+				}
+				if fields[j-1] == "login" {
+					current.login = fields[j]
+				} else if fields[j-1] == "password" {
+					current.password = fields[j]
+				}
+			case "macdef":
+				// A macro definition ends with an empty line
+				current = nil
+				for i+1 < len(lines) && strings.TrimSpace(lines[i+1]) != "" {
+					i++
+				}
+				j = len(fields)
+			}
+		}
+	}
+
+	if found == nil {
+		found = fallback
+	}
+	if found == nil || found.password == "" {
+		return "", "", false
+	}
+	return found.login, found.password, true
+}
+
+func expiry(maxAge time.Duration) time.Time {
+	if maxAge <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(maxAge)
+}
+
+// credentialCache caches the credential of a CredentialSource for a transport.
+type credentialCache struct {
+	mu   sync.Mutex
+	cred *Credential
+}
+
+// get returns the cached credential or fetches a new one from src.
+func (c *credentialCache) get(ctx context.Context, src CredentialSource) (*Credential, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cred != nil && (c.cred.Expiry.IsZero() || time.Now().Before(c.cred.Expiry)) {
+		return c.cred, nil
+	}
+
+	cred, err := src.Credential(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.cred = cred
+	return cred, nil
+}
+
+// invalidate drops cred from the cache, unless it was already replaced.
+func (c *credentialCache) invalidate(cred *Credential) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cred == cred {
+		c.cred = nil
+	}
+}
+
+// roundTripWithCredentials sends req authenticated by apply with the credential of src.
+// If Jira rejects the credential, it is invalidated and the request is retried once with a fresh credential.
+func roundTripWithCredentials(req *http.Request, src CredentialSource, cache *credentialCache, transport http.RoundTripper, apply func(*http.Request, *Credential) error) (*http.Response, error) {
+	cred, err := cache.get(req.Context(), src)
+	if err != nil {
+		return nil, fmt.Errorf("fetching credential: %w", err)
+	}
+
+	req2 := cloneRequest(req) // per RoundTripper contract
+	if err := apply(req2, cred); err != nil {
+		return nil, err
+	}
+	resp, err := transport.RoundTrip(req2)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The body of the original request is consumed, the request can only be retried if it can be rewound
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	cache.invalidate(cred)
+	fresh, err := cache.get(req.Context(), src)
+	if err != nil {
+		resp.Body.Close()
+		return nil, fmt.Errorf("fetching credential: %w", err)
+	}
+	if fresh.Username == cred.Username && fresh.Secret == cred.Secret {
+		// Nothing rotated, retrying would fail again
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	req3 := cloneRequest(req)
+	if req.GetBody != nil {
+		if req3.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	if err := apply(req3, fresh); err != nil {
+		return nil, err
+	}
+	return transport.RoundTrip(req3)
+}
@@ -2,7 +2,10 @@ package cloud
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 )
@@ -30,3 +33,17 @@ func TestJWTAuthTransport_HeaderContainsJWT(t *testing.T) {
 	jwtClient, _ := NewClient(testServer.URL, jwtTransport.Client())
 	jwtClient.Issue.Get(context.Background(), "TEST-1", nil)
 }
+
+func TestQueryStringHash(t *testing.T) {
+	u, _ := url.Parse("https://example.atlassian.net/rest/api/2/issue/TEST-1/?fields=summary,status&expand=names&jwt=abc.def.ghi")
+
+	want := "GET&/rest/api/2/issue/TEST-1&expand=names&fields=summary%2Cstatus"
+	if got := canonicalizeRequest(http.MethodGet, u); got != want {
+		t.Errorf("canonicalizeRequest() = %q, want %q", got, want)
+	}
+
+	h := sha256.Sum256([]byte(want))
+	if got := QueryStringHash("get", u); got != hex.EncodeToString(h[:]) {
+		t.Errorf("QueryStringHash() = %q, want %q", got, hex.EncodeToString(h[:]))
+	}
+}
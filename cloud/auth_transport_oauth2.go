@@ -0,0 +1,392 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// OAuth2AuthorizeURL is the authorization endpoint of Atlassian's OAuth 2.0 (3LO) flow.
+	OAuth2AuthorizeURL = "https://auth.atlassian.com/authorize"
+
+	// OAuth2TokenURL is the token endpoint of Atlassian's OAuth 2.0 (3LO) flow.
+	OAuth2TokenURL = "https://auth.atlassian.com/oauth/token"
+
+	// AccessibleResourcesURL lists the sites an OAuth 2.0 access token grants access to.
+	AccessibleResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+
+	// APIGatewayURL is the base of all Jira API requests authenticated with OAuth 2.0 (3LO) or scoped API tokens.
+	// The cloud ID of the site is appended, see GatewayURL.
+	APIGatewayURL = "https://api.atlassian.com/ex/jira/"
+
+	// oauth2ExpiryDelta refreshes access tokens shortly before they expire,
+	// to avoid sending requests with tokens that expire on the way.
+	oauth2ExpiryDelta = time.Minute
+)
+
+// OAuth2Token is an OAuth 2.0 (3LO) token.
+type OAuth2Token struct {
+	AccessToken string `json:"access_token"`
+	// RefreshToken is rotated by Atlassian on every refresh, the previous one becomes invalid.
+	RefreshToken string `json:"refresh_token,omitempty"`
+	TokenType    string `json:"token_type,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	ExpiresIn    int    `json:"expires_in,omitempty"`
+	// Expiry is the time the access token expires. It is computed from ExpiresIn.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// valid reports whether t has an access token that does not expire soon.
+func (t *OAuth2Token) valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(oauth2ExpiryDelta).Before(t.Expiry)
+}
+
+// OAuth2Error is returned if the token endpoint rejects a request,
+// for example because the refresh token expired or was revoked ("invalid_grant").
+type OAuth2Error struct {
+	StatusCode  int
+	ErrorCode   string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// Error implements the error interface.
+func (e *OAuth2Error) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("oauth2: %s: %s (status code %d)", e.ErrorCode, e.Description, e.StatusCode)
+	}
+	return fmt.Sprintf("oauth2: %s (status code %d)", e.ErrorCode, e.StatusCode)
+}
+
+// AccessibleResource is a site an OAuth 2.0 access token grants access to.
+type AccessibleResource struct {
+	// ID is the cloud ID of the site.
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	AvatarURL string   `json:"avatarUrl,omitempty"`
+}
+
+// OAuth2Transport is an http.RoundTripper that authenticates all requests
+// using OAuth 2.0 authorization code grants (3LO).
+//
+// The access token is refreshed with the refresh token when it expires
+// or when Jira answers with 401 Unauthorized.
+// Atlassian rotates refresh tokens, so every refreshed token must be persisted via OnTokenRefresh.
+//
+// Requests authenticated with OAuth 2.0 must be sent to the API gateway, see GatewayURL and NewClient.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/
+type OAuth2Transport struct {
+	ClientID     string
+	ClientSecret string
+
+	// Token is the current token. It is replaced on every refresh.
+	Token *OAuth2Token
+
+	// OnTokenRefresh is called with the new token after every refresh.
+	// If it returns an error, the request fails, but the new token is used by later requests.
+	OnTokenRefresh func(ctx context.Context, token *OAuth2Token) error
+
+	// TokenURL is the token endpoint. It defaults to OAuth2TokenURL.
+	TokenURL string
+
+	// AccessibleResourcesURL defaults to AccessibleResourcesURL.
+	AccessibleResourcesURL string
+
+	// GatewayURL is the base URL of the API gateway. It defaults to APIGatewayURL.
+	GatewayURL string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu sync.Mutex
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *OAuth2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.token(req.Context(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	req2 := cloneRequest(req) // per RoundTripper contract
+	req2.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	resp, err := t.transport().RoundTrip(req2)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || token.RefreshToken == "" {
+		return resp, err
+	}
+
+	// The access token was revoked or expired early: refresh it and replay the request once
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+	token, err = t.token(req.Context(), token.AccessToken)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req2 = cloneRequest(req)
+	if req.GetBody != nil {
+		if req2.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	req2.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using OAuth 2.0.
+func (t *OAuth2Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *OAuth2Transport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// token returns a valid token, refreshing it if needed.
+// If rejected is set, the token with this access token was rejected by Jira and is refreshed
+// unless another request refreshed it in the meantime.
+func (t *OAuth2Transport) token(ctx context.Context, rejected string) (*OAuth2Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Token.valid() && (rejected == "" || t.Token.AccessToken != rejected) {
+		return t.Token, nil
+	}
+	if t.Token == nil || t.Token.RefreshToken == "" {
+		return nil, errors.New("oauth2: access token expired and no refresh token available")
+	}
+
+	token, err := t.requestToken(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"refresh_token": t.Token.RefreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = t.Token.RefreshToken
+	}
+
+	// The old refresh token is invalid once rotated, so the new token is kept even if it cannot be persisted
+	t.Token = token
+	if t.OnTokenRefresh != nil {
+		if err := t.OnTokenRefresh(ctx, token); err != nil {
+			return nil, fmt.Errorf("oauth2: persisting refreshed token: %w", err)
+		}
+	}
+	return token, nil
+}
+
+// Exchange exchanges an authorization code for a token and stores it in the transport.
+// redirectURI must be the callback URL used to obtain the code.
+func (t *OAuth2Transport) Exchange(ctx context.Context, code, redirectURI string) (*OAuth2Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	token, err := t.requestToken(ctx, map[string]string{
+		"grant_type":   "authorization_code",
+		"code":         code,
+		"redirect_uri": redirectURI,
+	})
+	if err != nil {
+		return nil, err
+	}
+	t.Token = token
+	return token, nil
+}
+
+// requestToken sends a token request with the given parameters and the client credentials.
+func (t *OAuth2Transport) requestToken(ctx context.Context, params map[string]string) (*OAuth2Token, error) {
+	params["client_id"] = t.ClientID
+	params["client_secret"] = t.ClientSecret
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenURL := t.TokenURL
+	if tokenURL == "" {
+		tokenURL = OAuth2TokenURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		oerr := &OAuth2Error{StatusCode: resp.StatusCode}
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		if json.Unmarshal(b, oerr) != nil || oerr.ErrorCode == "" {
+			oerr.ErrorCode = http.StatusText(resp.StatusCode)
+		}
+		return nil, oerr
+	}
+
+	token := new(OAuth2Token)
+	if err := json.NewDecoder(resp.Body).Decode(token); err != nil {
+		return nil, fmt.Errorf("oauth2: could not parse token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("oauth2: token response contains no access token")
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// AccessibleResources returns the sites the token grants access to.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/#3-1-get-the-cloudid-for-your-site
+func (t *OAuth2Transport) AccessibleResources(ctx context.Context) ([]AccessibleResource, error) {
+	resourcesURL := t.AccessibleResourcesURL
+	if resourcesURL == "" {
+		resourcesURL = AccessibleResourcesURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resourcesURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := t.Client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	var resources []AccessibleResource
+	if err := json.NewDecoder(resp.Body).Decode(&resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// NewClient returns a Jira client for the site with the given URL (like "https://your-domain.atlassian.net"),
+// authenticated with this transport and sending requests through the API gateway.
+// If siteURL is empty, the token must grant access to exactly one site.
+func (t *OAuth2Transport) NewClient(ctx context.Context, siteURL string) (*Client, error) {
+	resources, err := t.AccessibleResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cloudID string
+	for _, r := range resources {
+		if siteURL == "" && len(resources) == 1 || siteURL != "" && sameSite(r.URL, siteURL) {
+			cloudID = r.ID
+			break
+		}
+	}
+	if cloudID == "" {
+		if siteURL == "" {
+			return nil, fmt.Errorf("oauth2: token grants access to %d sites, a site URL is required", len(resources))
+		}
+		return nil, fmt.Errorf("oauth2: token grants no access to %s", siteURL)
+	}
+
+	gatewayURL := t.GatewayURL
+	if gatewayURL == "" {
+		gatewayURL = APIGatewayURL
+	}
+	return NewClient(joinGatewayURL(gatewayURL, cloudID), t.Client())
+}
+
+// GatewayURL returns the base URL of the API gateway for the site with the given cloud ID.
+// It is used as baseURL of NewClient for OAuth 2.0 (3LO) and scoped API tokens.
+func GatewayURL(cloudID string) string {
+	return joinGatewayURL(APIGatewayURL, cloudID)
+}
+
+func joinGatewayURL(gatewayURL, cloudID string) string {
+	return strings.TrimSuffix(gatewayURL, "/") + "/" + url.PathEscape(cloudID) + "/"
+}
+
+// OAuth2AuthCodeURL returns the URL to redirect a user to for granting access to the app.
+// state protects against CSRF and is sent back to redirectURI together with the authorization code.
+// Add "offline_access" to scopes to receive a refresh token.
+func OAuth2AuthCodeURL(clientID, redirectURI, state string, scopes ...string) string {
+	v := url.Values{}
+	v.Set("audience", "api.atlassian.com")
+	v.Set("client_id", clientID)
+	v.Set("scope", strings.Join(scopes, " "))
+	v.Set("redirect_uri", redirectURI)
+	v.Set("state", state)
+	v.Set("response_type", "code")
+	v.Set("prompt", "consent")
+	return OAuth2AuthorizeURL + "?" + v.Encode()
+}
+
+// GetCloudID returns the cloud ID of the site with the given URL (like "https://your-domain.atlassian.net").
+// The cloud ID is needed to use scoped API tokens, which are sent to GatewayURL with a BasicAuthTransport.
+// If httpClient is nil, http.DefaultClient is used.
+func GetCloudID(ctx context.Context, httpClient *http.Client, siteURL string) (string, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(siteURL, "/")+"/_edge/tenant_info", nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if err := CheckResponse(resp); err != nil {
+		return "", err
+	}
+
+	var info struct {
+		CloudID string `json:"cloudId"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return "", err
+	}
+	if info.CloudID == "" {
+		return "", fmt.Errorf("no cloud ID found for %s", siteURL)
+	}
+	return info.CloudID, nil
+}
+
+// sameSite reports whether the URLs a and b point to the same host.
+func sameSite(a, b string) bool {
+	ua, errA := url.Parse(a)
+	ub, errB := url.Parse(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return strings.EqualFold(ua.Host, ub.Host)
+}
@@ -0,0 +1,298 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// setupOAuth2 registers a stand-in for the Atlassian token endpoint on testMux.
+// Every refresh returns a new access token and rotates the refresh token.
+func setupOAuth2(t *testing.T) *int {
+	refreshes := new(int)
+	testMux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var params map[string]string
+		json.NewDecoder(r.Body).Decode(&params)
+		if params["client_id"] != "client-id" || params["client_secret"] != "client-secret" {
+			t.Errorf("Unexpected client credentials: %v", params)
+		}
+
+		switch params["grant_type"] {
+		case "authorization_code":
+			if params["code"] != "auth-code" || params["redirect_uri"] != "https://app.example.com/callback" {
+				t.Errorf("Unexpected code exchange: %v", params)
+			}
+			fmt.Fprint(w, `{"access_token":"access-0","refresh_token":"refresh-0","expires_in":3600,"token_type":"Bearer","scope":"read:jira-work offline_access"}`)
+		case "refresh_token":
+			if params["refresh_token"] != fmt.Sprintf("refresh-%d", *refreshes) {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"error":"invalid_grant","error_description":"Unknown or invalid refresh token."}`)
+				return
+			}
+			*refreshes++
+			fmt.Fprintf(w, `{"access_token":"access-%d","refresh_token":"refresh-%d","expires_in":3600}`, *refreshes, *refreshes)
+		default:
+			t.Errorf("Unexpected grant type %q", params["grant_type"])
+		}
+	})
+	return refreshes
+}
+
+func TestOAuth2Transport_Exchange(t *testing.T) {
+	setup()
+	defer teardown()
+	setupOAuth2(t)
+
+	tp := &OAuth2Transport{ClientID: "client-id", ClientSecret: "client-secret", TokenURL: testServer.URL + "/oauth/token"}
+	token, err := tp.Exchange(context.Background(), "auth-code", "https://app.example.com/callback")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if token.AccessToken != "access-0" || token.RefreshToken != "refresh-0" || tp.Token != token {
+		t.Errorf("Unexpected token: %+v", token)
+	}
+	if time.Until(token.Expiry) < 59*time.Minute {
+		t.Errorf("Unexpected expiry: %v", token.Expiry)
+	}
+}
+
+func TestOAuth2Transport_RefreshExpired(t *testing.T) {
+	setup()
+	defer teardown()
+	refreshes := setupOAuth2(t)
+
+	testMux.HandleFunc("/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer access-1" {
+			t.Errorf("Authorization: %q, want %q", got, "Bearer access-1")
+		}
+		fmt.Fprint(w, `{"accountId":"abc"}`)
+	})
+
+	var persisted []string
+	tp := &OAuth2Transport{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		TokenURL:     testServer.URL + "/oauth/token",
+		Token:        &OAuth2Token{AccessToken: "access-0", RefreshToken: "refresh-0", Expiry: time.Now().Add(-time.Minute)},
+		OnTokenRefresh: func(ctx context.Context, token *OAuth2Token) error {
+			persisted = append(persisted, token.RefreshToken)
+			return nil
+		},
+	}
+
+	client, _ := NewClient(testServer.URL, tp.Client())
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.User.GetCurrentUser(context.Background()); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+
+	if *refreshes != 1 {
+		t.Errorf("Expected 1 refresh. Got %d", *refreshes)
+	}
+	if len(persisted) != 1 || persisted[0] != "refresh-1" {
+		t.Errorf("Rotated refresh token not persisted: %v", persisted)
+	}
+}
+
+func TestOAuth2Transport_RefreshOnUnauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+	setupOAuth2(t)
+
+	var bodies []string
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if r.Header.Get("Authorization") != "Bearer access-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"key":"TEST-1"}`)
+	})
+
+	tp := &OAuth2Transport{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		TokenURL:     testServer.URL + "/oauth/token",
+		Token:        &OAuth2Token{AccessToken: "revoked", RefreshToken: "refresh-0", Expiry: time.Now().Add(time.Hour)},
+	}
+
+	client, _ := NewClient(testServer.URL, tp.Client())
+	issue, _, err := client.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Summary: "Test"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "TEST-1" {
+		t.Errorf("Unexpected issue: %+v", issue)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] || bodies[0] == "" {
+		t.Errorf("Request body not replayed: %q", bodies)
+	}
+}
+
+func TestOAuth2Transport_InvalidGrant(t *testing.T) {
+	setup()
+	defer teardown()
+	setupOAuth2(t)
+
+	tp := &OAuth2Transport{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		TokenURL:     testServer.URL + "/oauth/token",
+		Token:        &OAuth2Token{AccessToken: "access-0", RefreshToken: "stale", Expiry: time.Now().Add(-time.Minute)},
+	}
+
+	client, _ := NewClient(testServer.URL, tp.Client())
+	_, _, err := client.User.GetCurrentUser(context.Background())
+
+	var oerr *OAuth2Error
+	if !errors.As(err, &oerr) {
+		t.Fatalf("Expected *OAuth2Error. Got %v", err)
+	}
+	if oerr.ErrorCode != "invalid_grant" || oerr.StatusCode != http.StatusForbidden {
+		t.Errorf("Unexpected error: %+v", oerr)
+	}
+}
+
+func TestOAuth2Transport_InvalidGrantOnUnauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+	setupOAuth2(t)
+
+	testMux.HandleFunc("/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	tp := &OAuth2Transport{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		TokenURL:     testServer.URL + "/oauth/token",
+		Token:        &OAuth2Token{AccessToken: "revoked", RefreshToken: "stale", Expiry: time.Now().Add(time.Hour)},
+	}
+
+	client, _ := NewClient(testServer.URL, tp.Client())
+	_, _, err := client.User.GetCurrentUser(context.Background())
+
+	var oerr *OAuth2Error
+	if !errors.As(err, &oerr) || oerr.ErrorCode != "invalid_grant" {
+		t.Errorf("Expected the invalid_grant *OAuth2Error of the refresh. Got %v", err)
+	}
+}
+
+func TestOAuth2Transport_PersistFailure(t *testing.T) {
+	setup()
+	defer teardown()
+	refreshes := setupOAuth2(t)
+
+	testMux.HandleFunc("/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"accountId":"abc"}`)
+	})
+
+	errStore := errors.New("store unavailable")
+	tp := &OAuth2Transport{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		TokenURL:     testServer.URL + "/oauth/token",
+		Token:        &OAuth2Token{AccessToken: "access-0", RefreshToken: "refresh-0", Expiry: time.Now().Add(-time.Minute)},
+		OnTokenRefresh: func(ctx context.Context, token *OAuth2Token) error {
+			return errStore
+		},
+	}
+
+	client, _ := NewClient(testServer.URL, tp.Client())
+	if _, _, err := client.User.GetCurrentUser(context.Background()); !errors.Is(err, errStore) {
+		t.Fatalf("Expected the error of OnTokenRefresh. Got %v", err)
+	}
+	// The rotated refresh token is kept, so later requests don't fail with invalid_grant
+	if tp.Token.RefreshToken != "refresh-1" {
+		t.Errorf("Expected the rotated token to be kept. Got %+v", tp.Token)
+	}
+	if _, _, err := client.User.GetCurrentUser(context.Background()); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if *refreshes != 1 {
+		t.Errorf("Expected 1 refresh. Got %d", *refreshes)
+	}
+}
+
+func TestOAuth2Transport_NewClient(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/oauth/token/accessible-resources", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer access-0" {
+			t.Errorf("Authorization: %q", got)
+		}
+		fmt.Fprint(w, `[{"id":"1324a887-45db-1bf4-1e99-ef0ff456d421","url":"https://your-domain.atlassian.net","name":"your-domain","scopes":["read:jira-work"]},{"id":"other","url":"https://other.atlassian.net","name":"other"}]`)
+	})
+	testMux.HandleFunc("/ex/jira/1324a887-45db-1bf4-1e99-ef0ff456d421/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"accountId":"abc"}`)
+	})
+
+	tp := &OAuth2Transport{
+		Token:                  &OAuth2Token{AccessToken: "access-0"},
+		AccessibleResourcesURL: testServer.URL + "/oauth/token/accessible-resources",
+		GatewayURL:             testServer.URL + "/ex/jira",
+	}
+
+	client, err := tp.NewClient(context.Background(), "https://YOUR-DOMAIN.atlassian.net/")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := testServer.URL + "/ex/jira/1324a887-45db-1bf4-1e99-ef0ff456d421/"
+	if client.BaseURL.String() != want {
+		t.Errorf("BaseURL: %s, want %s", client.BaseURL, want)
+	}
+	user, _, err := client.User.GetCurrentUser(context.Background())
+	if err != nil || user.AccountID != "abc" {
+		t.Errorf("Request through gateway failed: %v", err)
+	}
+
+	if _, err := tp.NewClient(context.Background(), ""); err == nil {
+		t.Error("Expected an error for several sites without site URL. Got none")
+	}
+	if _, err := tp.NewClient(context.Background(), "https://unknown.atlassian.net"); err == nil {
+		t.Error("Expected an error for an unknown site. Got none")
+	}
+}
+
+func TestGetCloudID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/_edge/tenant_info", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"cloudId":"1324a887-45db-1bf4-1e99-ef0ff456d421"}`)
+	})
+
+	cloudID, err := GetCloudID(context.Background(), nil, testServer.URL+"/")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if cloudID != "1324a887-45db-1bf4-1e99-ef0ff456d421" {
+		t.Errorf("Cloud ID: %q", cloudID)
+	}
+	if got, want := GatewayURL(cloudID), "https://api.atlassian.com/ex/jira/1324a887-45db-1bf4-1e99-ef0ff456d421/"; got != want {
+		t.Errorf("GatewayURL: %s, want %s", got, want)
+	}
+}
+
+func TestOAuth2AuthCodeURL(t *testing.T) {
+	u, err := url.Parse(OAuth2AuthCodeURL("client-id", "https://app.example.com/callback", "state-123", "read:jira-work", "offline_access"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	q := u.Query()
+	if q.Get("client_id") != "client-id" || q.Get("state") != "state-123" || q.Get("scope") != "read:jira-work offline_access" || q.Get("audience") != "api.atlassian.com" || q.Get("response_type") != "code" {
+		t.Errorf("Unexpected query: %v", q)
+	}
+}
@@ -79,3 +79,23 @@ func TestComponentService_Get_NoComponent(t *testing.T) {
 		t.Error("No error given. Expected one")
 	}
 }
+
+func TestComponentService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/component/42102"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/3/component/42102","id":"42102","name":"Component 1","description":"Updated"}`)
+	})
+
+	component, _, err := testClient.Component.Update(context.Background(), "42102", &ComponentCreateOptions{Description: "Updated"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if component == nil || component.Description != "Updated" {
+		t.Errorf("Expected updated component. Got %+v", component)
+	}
+}
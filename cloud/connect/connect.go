@@ -0,0 +1,95 @@
+// Package connect verifies requests Jira Cloud sends to Atlassian Connect apps.
+//
+// Jira signs every request to a Connect app with a JWT: module requests and webhooks use the
+// shared secret of the installation (HS256), install and uninstall lifecycle callbacks
+// are signed asymmetrically by Atlassian (RS256).
+// The Verifier checks the signature, issuer, expiry and query string hash of these tokens,
+// using the same canonicalization as cloud.JWTAuthTransport uses for outgoing requests.
+// The LifecycleHandler stores the installations in a TenantStore.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/understanding-jwt-for-connect-apps/
+package connect
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrTenantNotFound is returned by a TenantStore if no tenant with the given client key exists.
+var ErrTenantNotFound = errors.New("connect: tenant not found")
+
+// Tenant is an installation of the Connect app in a Jira Cloud site,
+// as sent by Jira in the lifecycle callbacks.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/connect-app-descriptor/#lifecycle-http-request-payload
+type Tenant struct {
+	// Key is the key of the app.
+	Key string `json:"key"`
+	// ClientKey identifies the installation. It is the "iss" claim of JWTs sent by this installation.
+	ClientKey string `json:"clientKey"`
+	// SharedSecret is used to sign and verify JWTs of this installation.
+	SharedSecret             string `json:"sharedSecret"`
+	BaseURL                  string `json:"baseUrl"`
+	DisplayURL               string `json:"displayUrl,omitempty"`
+	ProductType              string `json:"productType"`
+	Description              string `json:"description,omitempty"`
+	ServerVersion            string `json:"serverVersion,omitempty"`
+	PluginsVersion           string `json:"pluginsVersion,omitempty"`
+	ServiceEntitlementNumber string `json:"serviceEntitlementNumber,omitempty"`
+	OAuthClientID            string `json:"oauthClientId,omitempty"`
+	CloudID                  string `json:"cloudId,omitempty"`
+}
+
+// TenantStore persists the installations of a Connect app.
+// Implementations must be safe for concurrent use.
+type TenantStore interface {
+	// Get returns the tenant with the given client key or ErrTenantNotFound.
+	Get(ctx context.Context, clientKey string) (*Tenant, error)
+
+	// Save creates or replaces a tenant.
+	Save(ctx context.Context, tenant *Tenant) error
+
+	// Delete removes the tenant with the given client key.
+	// Deleting an unknown tenant is not an error.
+	Delete(ctx context.Context, clientKey string) error
+}
+
+// MemoryTenantStore is a TenantStore keeping the tenants in memory.
+// It is meant for tests and development, installations are lost on restart.
+type MemoryTenantStore struct {
+	mu      sync.RWMutex
+	tenants map[string]Tenant
+}
+
+// NewMemoryTenantStore returns an empty MemoryTenantStore.
+func NewMemoryTenantStore() *MemoryTenantStore {
+	return &MemoryTenantStore{tenants: make(map[string]Tenant)}
+}
+
+// Get implements the TenantStore interface.
+func (s *MemoryTenantStore) Get(ctx context.Context, clientKey string) (*Tenant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	tenant, ok := s.tenants[clientKey]
+	if !ok {
+		return nil, ErrTenantNotFound
+	}
+	return &tenant, nil
+}
+
+// Save implements the TenantStore interface.
+func (s *MemoryTenantStore) Save(ctx context.Context, tenant *Tenant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tenants[tenant.ClientKey] = *tenant
+	return nil
+}
+
+// Delete implements the TenantStore interface.
+func (s *MemoryTenantStore) Delete(ctx context.Context, clientKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tenants, clientKey)
+	return nil
+}
@@ -0,0 +1,38 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMemoryTenantStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTenantStore()
+
+	if _, err := store.Get(ctx, "tenant-1"); !errors.Is(err, ErrTenantNotFound) {
+		t.Errorf("Expected ErrTenantNotFound. Got %v", err)
+	}
+
+	tenant := &Tenant{ClientKey: "tenant-1", SharedSecret: "secret", BaseURL: "https://example.atlassian.net"}
+	if err := store.Save(ctx, tenant); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	// Changing the saved tenant must not change the stored one
+	tenant.SharedSecret = "changed"
+
+	got, err := store.Get(ctx, "tenant-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got.SharedSecret != "secret" {
+		t.Errorf("SharedSecret: %q, want %q", got.SharedSecret, "secret")
+	}
+
+	if err := store.Delete(ctx, "tenant-1"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, err := store.Get(ctx, "tenant-1"); !errors.Is(err, ErrTenantNotFound) {
+		t.Errorf("Expected ErrTenantNotFound after delete. Got %v", err)
+	}
+}
@@ -0,0 +1,301 @@
+package connect
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// DefaultInstallKeysURL is the location of the public keys Atlassian signs lifecycle callbacks with.
+const DefaultInstallKeysURL = "https://connect-install-keys.atlassian.com/"
+
+// Lifecycle event types.
+const (
+	EventInstalled   = "installed"
+	EventUninstalled = "uninstalled"
+	EventEnabled     = "enabled"
+	EventDisabled    = "disabled"
+)
+
+// maxLifecyclePayloadSize limits the size of a lifecycle callback payload.
+const maxLifecyclePayloadSize = 1 << 20
+
+// The key ID of a token is read before the token is verified, so anyone can make the InstallVerifier
+// look up keys. Unknown key IDs are remembered for installKeyMissTTL, at most maxInstallKeyMisses of them,
+// and at most maxInstallKeyFetches keys are fetched at once.
+const (
+	installKeyMissTTL    = 5 * time.Minute
+	maxInstallKeyMisses  = 1024
+	maxInstallKeyFetches = 16
+)
+
+// installKeysClient is the default client fetching public keys.
+var installKeysClient = &http.Client{Timeout: 10 * time.Second}
+
+// LifecycleEvent is the payload of a lifecycle callback.
+type LifecycleEvent struct {
+	Tenant
+	EventType string `json:"eventType"`
+}
+
+// InstallVerifier verifies the install and uninstall lifecycle callbacks.
+// Atlassian signs these requests with RS256. The public key is identified by the "kid" header
+// of the token and fetched from KeysURL.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/security-for-connect-apps/#validating-installation-lifecycle-requests
+type InstallVerifier struct {
+	// BaseURL is the baseUrl of the app descriptor. The "aud" claim must match it.
+	BaseURL string
+
+	// KeysURL is the location of the public keys.
+	// It defaults to DefaultInstallKeysURL.
+	KeysURL string
+
+	// HTTPClient is used to fetch public keys.
+	// It defaults to a client with a timeout of 10 seconds.
+	HTTPClient *http.Client
+
+	// Leeway is the allowed clock skew when checking the "exp", "iat" and "nbf" claims.
+	Leeway time.Duration
+
+	// mu protects the fields below, it is not held while fetching keys
+	mu      sync.Mutex
+	keys    map[string]*rsa.PublicKey
+	misses  map[string]time.Time // key ID: time of the lookup which did not find the key
+	fetches map[string]*keyFetch // key ID: fetch in flight
+}
+
+// keyFetch is the fetch of a public key, shared by all verifications waiting for the key.
+type keyFetch struct {
+	done chan struct{}
+	key  *rsa.PublicKey
+	err  error
+}
+
+// Verify verifies the JWT of the lifecycle callback r.
+func (v *InstallVerifier) Verify(r *http.Request) (*Claims, error) {
+	tokenString := tokenFromRequest(r)
+	if tokenString == "" {
+		return nil, fmt.Errorf("%w: missing JWT", ErrInvalidToken)
+	}
+
+	claims := &Claims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}), jwt.WithoutClaimsValidation())
+	_, err := parser.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, errors.New("missing kid header")
+		}
+		return v.publicKey(r.Context(), kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	if err := validateClaims(claims, v.Leeway); err != nil {
+		return nil, err
+	}
+	if !claims.VerifyAudience(v.BaseURL, true) {
+		return nil, fmt.Errorf("%w: audience mismatch", ErrInvalidToken)
+	}
+	if claims.QSH != queryStringHash(r, v.BaseURL) {
+		return nil, fmt.Errorf("%w: query string hash mismatch", ErrInvalidToken)
+	}
+
+	return claims, nil
+}
+
+// publicKey returns the public key with the given key ID.
+// Keys are cached, and concurrent lookups of the same key ID share one fetch.
+func (v *InstallVerifier) publicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	v.mu.Lock()
+	if key, ok := v.keys[kid]; ok {
+		v.mu.Unlock()
+		return key, nil
+	}
+	if missed, ok := v.misses[kid]; ok && time.Since(missed) < installKeyMissTTL {
+		v.mu.Unlock()
+		return nil, fmt.Errorf("unknown public key %s", kid)
+	}
+	f, ok := v.fetches[kid]
+	if !ok {
+		if len(v.fetches) >= maxInstallKeyFetches {
+			v.mu.Unlock()
+			return nil, errors.New("too many public keys being fetched")
+		}
+		if v.fetches == nil {
+			v.fetches = make(map[string]*keyFetch)
+		}
+		f = &keyFetch{done: make(chan struct{})}
+		v.fetches[kid] = f
+		// The fetch is shared, so it must not be canceled with the request starting it
+		go v.fetch(context.WithoutCancel(ctx), kid, f)
+	}
+	v.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.key, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetch fetches the public key with the given key ID and stores the result.
+func (v *InstallVerifier) fetch(ctx context.Context, kid string, f *keyFetch) {
+	var notFound bool
+	f.key, notFound, f.err = v.fetchPublicKey(ctx, kid)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.fetches, kid)
+	switch {
+	case f.err == nil:
+		if v.keys == nil {
+			v.keys = make(map[string]*rsa.PublicKey)
+		}
+		v.keys[kid] = f.key
+	case notFound:
+		if v.misses == nil || len(v.misses) >= maxInstallKeyMisses {
+			v.misses = make(map[string]time.Time)
+		}
+		v.misses[kid] = time.Now()
+	}
+	close(f.done)
+}
+
+// fetchPublicKey requests the public key with the given key ID from KeysURL.
+// notFound reports whether the keys service does not know the key ID.
+func (v *InstallVerifier) fetchPublicKey(ctx context.Context, kid string) (key *rsa.PublicKey, notFound bool, err error) {
+	keysURL := v.KeysURL
+	if keysURL == "" {
+		keysURL = DefaultInstallKeysURL
+	}
+	if !strings.HasSuffix(keysURL, "/") {
+		keysURL += "/"
+	}
+	httpClient := v.HTTPClient
+	if httpClient == nil {
+		httpClient = installKeysClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, keysURL+url.PathEscape(kid), nil)
+	if err != nil {
+		return nil, false, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, false, fmt.Errorf("fetching public key %s: %w", kid, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		notFound = resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden
+		return nil, notFound, fmt.Errorf("fetching public key %s: status code %d", kid, resp.StatusCode)
+	}
+
+	pem, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return nil, false, fmt.Errorf("fetching public key %s: %w", kid, err)
+	}
+	key, err = jwt.ParseRSAPublicKeyFromPEM(pem)
+	if err != nil {
+		return nil, false, fmt.Errorf("parsing public key %s: %w", kid, err)
+	}
+	return key, false, nil
+}
+
+// LifecycleHandler is an http.Handler for the lifecycle callbacks of a Connect app.
+// Register it for the "installed" and "uninstalled" URLs of the app descriptor.
+// It verifies the callbacks and saves or deletes the tenant in Store.
+//
+// Response codes:
+//   - 204 No Content if the callback was handled
+//   - 400 Bad Request if the payload could not be parsed
+//   - 401 Unauthorized if the request could not be verified
+//   - 405 Method Not Allowed for other methods than POST
+//   - 500 Internal Server Error if the Store or a hook failed
+type LifecycleHandler struct {
+	Store    TenantStore
+	Verifier *InstallVerifier
+
+	// OnInstalled is called after a tenant was saved.
+	OnInstalled func(ctx context.Context, tenant *Tenant) error
+
+	// OnUninstalled is called before a tenant is deleted.
+	OnUninstalled func(ctx context.Context, tenant *Tenant) error
+
+	// ErrorLog is called with errors of requests that could not be handled.
+	// If nil, errors are not reported.
+	ErrorLog func(r *http.Request, err error)
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *LifecycleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxLifecyclePayloadSize))
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	claims, err := h.Verifier.Verify(r)
+	if err != nil {
+		h.fail(w, r, http.StatusUnauthorized, err)
+		return
+	}
+
+	var event LifecycleEvent
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&event); err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if event.ClientKey == "" || event.ClientKey != claims.Issuer {
+		h.fail(w, r, http.StatusUnauthorized, fmt.Errorf("%w: clientKey does not match iss claim", ErrInvalidToken))
+		return
+	}
+
+	ctx := r.Context()
+	switch event.EventType {
+	case EventInstalled:
+		err = h.Store.Save(ctx, &event.Tenant)
+		if err == nil && h.OnInstalled != nil {
+			err = h.OnInstalled(ctx, &event.Tenant)
+		}
+	case EventUninstalled:
+		if h.OnUninstalled != nil {
+			err = h.OnUninstalled(ctx, &event.Tenant)
+		}
+		if err == nil {
+			err = h.Store.Delete(ctx, event.ClientKey)
+		}
+	}
+	if err != nil {
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *LifecycleHandler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.ErrorLog != nil {
+		h.ErrorLog(r, err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
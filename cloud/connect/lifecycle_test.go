@@ -0,0 +1,220 @@
+package connect
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// setupInstallKeys starts a stand-in for the Atlassian install keys service
+// and returns its URL together with the private key of key ID "key-1".
+func setupInstallKeys(t *testing.T) (string, *rsa.PrivateKey, *atomic.Int32) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating key: %s", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("Error marshalling key: %s", err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	fetches := new(atomic.Int32)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		if r.URL.Path != "/key-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(publicPEM)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL, key, fetches
+}
+
+func rs256Token(t *testing.T, key *rsa.PrivateKey, kid, iss, aud, qsh string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss": iss,
+		"aud": aud,
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(time.Minute).Unix(),
+		"qsh": qsh,
+	})
+	token.Header["kid"] = kid
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("Error signing token: %s", err)
+	}
+	return s
+}
+
+func lifecycleRequest(token, eventType, clientKey string) *http.Request {
+	body := `{"key":"my-app","clientKey":"` + clientKey + `","sharedSecret":"secret","baseUrl":"https://example.atlassian.net","productType":"jira","eventType":"` + eventType + `"}`
+	r := httptest.NewRequest(http.MethodPost, testAppBaseURL+"/"+eventType, strings.NewReader(body))
+	if token != "" {
+		r.Header.Set("Authorization", "JWT "+token)
+	}
+	return r
+}
+
+func TestLifecycleHandler(t *testing.T) {
+	keysURL, key, fetches := setupInstallKeys(t)
+	store := NewMemoryTenantStore()
+
+	var installed, uninstalled string
+	h := &LifecycleHandler{
+		Store:    store,
+		Verifier: &InstallVerifier{BaseURL: testAppBaseURL, KeysURL: keysURL},
+		OnInstalled: func(ctx context.Context, tenant *Tenant) error {
+			installed = tenant.ClientKey
+			return nil
+		},
+		OnUninstalled: func(ctx context.Context, tenant *Tenant) error {
+			uninstalled = tenant.ClientKey
+			return nil
+		},
+	}
+
+	token := rs256Token(t, key, "key-1", "tenant-1", testAppBaseURL, qsh(http.MethodPost, "/installed"))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, lifecycleRequest(token, EventInstalled, "tenant-1"))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("Status: %d, want %d", w.Code, http.StatusNoContent)
+	}
+	tenant, err := store.Get(context.Background(), "tenant-1")
+	if err != nil {
+		t.Fatalf("Tenant not saved: %s", err)
+	}
+	if tenant.SharedSecret != "secret" || tenant.BaseURL != "https://example.atlassian.net" || installed != "tenant-1" {
+		t.Errorf("Unexpected tenant %+v, installed hook %q", tenant, installed)
+	}
+
+	token = rs256Token(t, key, "key-1", "tenant-1", testAppBaseURL, qsh(http.MethodPost, "/uninstalled"))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, lifecycleRequest(token, EventUninstalled, "tenant-1"))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("Status: %d, want %d", w.Code, http.StatusNoContent)
+	}
+	if _, err := store.Get(context.Background(), "tenant-1"); !errors.Is(err, ErrTenantNotFound) {
+		t.Errorf("Tenant not deleted: %v", err)
+	}
+	if uninstalled != "tenant-1" {
+		t.Errorf("Uninstalled hook not called")
+	}
+
+	// The public key is fetched only once
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("Expected 1 key fetch. Got %d", n)
+	}
+}
+
+func TestLifecycleHandler_Unauthorized(t *testing.T) {
+	keysURL, key, _ := setupInstallKeys(t)
+	other, _ := rsa.GenerateKey(rand.Reader, 2048)
+	installQSH := qsh(http.MethodPost, "/installed")
+
+	tests := []struct {
+		name      string
+		token     string
+		clientKey string
+	}{
+		{"missing token", "", "tenant-1"},
+		{"wrong key", rs256Token(t, other, "key-1", "tenant-1", testAppBaseURL, installQSH), "tenant-1"},
+		{"unknown key ID", rs256Token(t, key, "key-2", "tenant-1", testAppBaseURL, installQSH), "tenant-1"},
+		{"wrong audience", rs256Token(t, key, "key-1", "tenant-1", "https://evil.example.com", installQSH), "tenant-1"},
+		{"wrong qsh", rs256Token(t, key, "key-1", "tenant-1", testAppBaseURL, qsh(http.MethodPost, "/uninstalled")), "tenant-1"},
+		{"client key mismatch", rs256Token(t, key, "key-1", "tenant-1", testAppBaseURL, installQSH), "tenant-2"},
+		{"shared secret token", hs256Token(t, "secret", "tenant-1", installQSH, time.Now().Add(time.Minute)), "tenant-1"},
+	}
+
+	for _, tt := range tests {
+		store := NewMemoryTenantStore()
+		h := &LifecycleHandler{Store: store, Verifier: &InstallVerifier{BaseURL: testAppBaseURL, KeysURL: keysURL}}
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, lifecycleRequest(tt.token, EventInstalled, tt.clientKey))
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("%s: Status %d, want %d", tt.name, w.Code, http.StatusUnauthorized)
+		}
+		if _, err := store.Get(context.Background(), tt.clientKey); !errors.Is(err, ErrTenantNotFound) {
+			t.Errorf("%s: Tenant must not be saved", tt.name)
+		}
+	}
+}
+
+func TestInstallVerifier_UnknownKeyID(t *testing.T) {
+	keysURL, key, fetches := setupInstallKeys(t)
+	v := &InstallVerifier{BaseURL: testAppBaseURL, KeysURL: keysURL}
+
+	token := rs256Token(t, key, "key-2", "tenant-1", testAppBaseURL, qsh(http.MethodPost, "/installed"))
+	for i := 0; i < 3; i++ {
+		if _, err := v.Verify(lifecycleRequest(token, EventInstalled, "tenant-1")); err == nil {
+			t.Fatalf("Expected error for unknown key ID")
+		}
+	}
+
+	// The unknown key ID is looked up only once
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("Expected 1 key fetch. Got %d", n)
+	}
+}
+
+func TestInstallVerifier_ConcurrentFetch(t *testing.T) {
+	keysURL, key, fetches := setupInstallKeys(t)
+	v := &InstallVerifier{BaseURL: testAppBaseURL, KeysURL: keysURL}
+	token := rs256Token(t, key, "key-1", "tenant-1", testAppBaseURL, qsh(http.MethodPost, "/installed"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := v.Verify(lifecycleRequest(token, EventInstalled, "tenant-1")); err != nil {
+				t.Errorf("Error given: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Concurrent verifications share one fetch
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("Expected 1 key fetch. Got %d", n)
+	}
+}
+
+func TestInstallVerifier_Canceled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+	v := &InstallVerifier{BaseURL: testAppBaseURL, KeysURL: server.URL}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := v.publicKey(ctx, "key-1"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded. Got %v", err)
+	}
+
+	// A slow fetch does not block lookups of other keys
+	v.mu.Lock()
+	v.keys = map[string]*rsa.PublicKey{"key-2": {}}
+	v.mu.Unlock()
+	if _, err := v.publicKey(context.Background(), "key-2"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,155 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+
+	jira "github.com/conductorone/go-jira/v2/cloud"
+)
+
+// ContextQSH is the query string hash of tokens that are not bound to a specific request,
+// like the tokens of iframe modules passed on by the app frontend.
+const ContextQSH = "context-qsh"
+
+// ErrInvalidToken is returned (wrapped) if a request does not carry a valid JWT.
+var ErrInvalidToken = errors.New("connect: invalid token")
+
+// Claims are the claims of a JWT sent by Jira to a Connect app.
+type Claims struct {
+	jwt.RegisteredClaims
+
+	// QSH is the query string hash binding the token to the request.
+	QSH string `json:"qsh"`
+
+	// Context holds additional information about the user and the product, if present.
+	Context map[string]interface{} `json:"context,omitempty"`
+}
+
+// Verifier verifies requests signed with the shared secret of an installation (HS256).
+// These are all requests to the app except the install and uninstall lifecycle callbacks.
+type Verifier struct {
+	// Store provides the shared secret of the installation identified by the "iss" claim.
+	Store TenantStore
+
+	// BaseURL is the baseUrl of the app descriptor.
+	// Its path is removed from the request path before computing the query string hash.
+	BaseURL string
+
+	// Leeway is the allowed clock skew when checking the "exp", "iat" and "nbf" claims.
+	Leeway time.Duration
+
+	// AllowContextQSH accepts tokens with the query string hash ContextQSH
+	// instead of a hash of the request.
+	AllowContextQSH bool
+}
+
+// Verify verifies the JWT of r, passed in the Authorization header ("JWT <token>")
+// or the "jwt" query parameter.
+// It returns the tenant that signed the request and the claims of the token.
+func (v *Verifier) Verify(r *http.Request) (*Tenant, *Claims, error) {
+	tokenString := tokenFromRequest(r)
+	if tokenString == "" {
+		return nil, nil, fmt.Errorf("%w: missing JWT", ErrInvalidToken)
+	}
+
+	var tenant *Tenant
+	claims := &Claims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithoutClaimsValidation())
+	_, err := parser.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if claims.Issuer == "" {
+			return nil, errors.New("missing iss claim")
+		}
+		var err error
+		tenant, err = v.Store.Get(r.Context(), claims.Issuer)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(tenant.SharedSecret), nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	if err := validateClaims(claims, v.Leeway); err != nil {
+		return nil, nil, err
+	}
+	if !(v.AllowContextQSH && claims.QSH == ContextQSH) && claims.QSH != queryStringHash(r, v.BaseURL) {
+		return nil, nil, fmt.Errorf("%w: query string hash mismatch", ErrInvalidToken)
+	}
+
+	return tenant, claims, nil
+}
+
+// Middleware returns an http.Handler that verifies requests before passing them to next.
+// Requests that fail the verification are answered with 401 Unauthorized.
+// The tenant and claims are available to next via TenantFromContext and ClaimsFromContext.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant, claims, err := v.Verify(r)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), tenantContextKey{}, tenant)
+		ctx = context.WithValue(ctx, claimsContextKey{}, claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+type tenantContextKey struct{}
+
+type claimsContextKey struct{}
+
+// TenantFromContext returns the tenant of a request verified by Verifier.Middleware.
+func TenantFromContext(ctx context.Context) (*Tenant, bool) {
+	tenant, ok := ctx.Value(tenantContextKey{}).(*Tenant)
+	return tenant, ok
+}
+
+// ClaimsFromContext returns the claims of a request verified by Verifier.Middleware.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*Claims)
+	return claims, ok
+}
+
+func tokenFromRequest(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "JWT ") {
+		return strings.TrimPrefix(auth, "JWT ")
+	}
+	return r.URL.Query().Get("jwt")
+}
+
+// validateClaims checks the time based claims of c, allowing a clock skew of leeway.
+func validateClaims(c *Claims, leeway time.Duration) error {
+	now := time.Now()
+	if !c.VerifyExpiresAt(now.Add(-leeway), true) {
+		return fmt.Errorf("%w: token is expired", ErrInvalidToken)
+	}
+	if !c.VerifyIssuedAt(now.Add(leeway), false) {
+		return fmt.Errorf("%w: token used before issued", ErrInvalidToken)
+	}
+	if !c.VerifyNotBefore(now.Add(leeway), false) {
+		return fmt.Errorf("%w: token is not valid yet", ErrInvalidToken)
+	}
+	return nil
+}
+
+// queryStringHash returns the query string hash of r.
+// The path of the app base URL is not part of the canonical request.
+func queryStringHash(r *http.Request, baseURL string) string {
+	u := *r.URL
+	if baseURL != "" {
+		if base, err := url.Parse(baseURL); err == nil {
+			u.Path = strings.TrimPrefix(u.Path, strings.TrimSuffix(base.Path, "/"))
+		}
+	}
+	return jira.QueryStringHash(r.Method, &u)
+}
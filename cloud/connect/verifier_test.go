@@ -0,0 +1,130 @@
+package connect
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+
+	jira "github.com/conductorone/go-jira/v2/cloud"
+)
+
+const testAppBaseURL = "https://app.example.com/jira"
+
+func hs256Token(t *testing.T, secret, iss, qsh string, exp time.Time) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": iss,
+		"iat": time.Now().Unix(),
+		"exp": exp.Unix(),
+		"qsh": qsh,
+	})
+	s, err := token.SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("Error signing token: %s", err)
+	}
+	return s
+}
+
+func qsh(method, target string) string {
+	u, _ := url.Parse(target)
+	return jira.QueryStringHash(method, u)
+}
+
+func testVerifier() *Verifier {
+	store := NewMemoryTenantStore()
+	store.Save(context.Background(), &Tenant{ClientKey: "tenant-1", SharedSecret: "secret"})
+	return &Verifier{Store: store, BaseURL: testAppBaseURL}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	v := testVerifier()
+	inAnHour := time.Now().Add(time.Hour)
+	requestQSH := qsh(http.MethodGet, "/panel?issueKey=TEST-1")
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"valid", hs256Token(t, "secret", "tenant-1", requestQSH, inAnHour), true},
+		{"wrong secret", hs256Token(t, "wrong", "tenant-1", requestQSH, inAnHour), false},
+		{"unknown tenant", hs256Token(t, "secret", "tenant-2", requestQSH, inAnHour), false},
+		{"missing iss", hs256Token(t, "secret", "", requestQSH, inAnHour), false},
+		{"expired", hs256Token(t, "secret", "tenant-1", requestQSH, time.Now().Add(-time.Minute)), false},
+		{"wrong qsh", hs256Token(t, "secret", "tenant-1", qsh(http.MethodPost, "/panel?issueKey=TEST-1"), inAnHour), false},
+		{"context qsh", hs256Token(t, "secret", "tenant-1", ContextQSH, inAnHour), false},
+		{"missing", "", false},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, testAppBaseURL+"/panel?issueKey=TEST-1", nil)
+		if tt.token != "" {
+			r.Header.Set("Authorization", "JWT "+tt.token)
+		}
+		tenant, claims, err := v.Verify(r)
+		if tt.valid {
+			if err != nil {
+				t.Errorf("%s: Error given: %s", tt.name, err)
+			} else if tenant.ClientKey != "tenant-1" || claims.Issuer != "tenant-1" {
+				t.Errorf("%s: Unexpected tenant %+v or claims %+v", tt.name, tenant, claims)
+			}
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: Expected ErrInvalidToken. Got %v", tt.name, err)
+		}
+	}
+}
+
+func TestVerifier_Verify_Options(t *testing.T) {
+	v := testVerifier()
+	v.AllowContextQSH = true
+	v.Leeway = time.Minute
+
+	// Tokens slightly expired are accepted within the leeway, the token is passed as query parameter
+	token := hs256Token(t, "secret", "tenant-1", ContextQSH, time.Now().Add(-30*time.Second))
+	r := httptest.NewRequest(http.MethodGet, testAppBaseURL+"/panel?jwt="+token, nil)
+	if _, _, err := v.Verify(r); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	// Tokens signed with another algorithm are rejected
+	none, _ := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{"iss": "tenant-1", "qsh": ContextQSH, "exp": time.Now().Add(time.Hour).Unix()}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	r = httptest.NewRequest(http.MethodGet, testAppBaseURL+"/panel?jwt="+none, nil)
+	if _, _, err := v.Verify(r); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Expected ErrInvalidToken. Got %v", err)
+	}
+}
+
+func TestVerifier_Middleware(t *testing.T) {
+	v := testVerifier()
+
+	var gotTenant *Tenant
+	var gotClaims *Claims
+	handler := v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTenant, _ = TenantFromContext(r.Context())
+		gotClaims, _ = ClaimsFromContext(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, testAppBaseURL+"/panel", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Status: %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, testAppBaseURL+"/panel", nil)
+	r.Header.Set("Authorization", "JWT "+hs256Token(t, "secret", "tenant-1", qsh(http.MethodGet, "/panel"), time.Now().Add(time.Minute)))
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Errorf("Status: %d, want %d", w.Code, http.StatusOK)
+	}
+	if gotTenant == nil || gotTenant.ClientKey != "tenant-1" || gotClaims == nil {
+		t.Errorf("Tenant and claims not passed in context: %+v %+v", gotTenant, gotClaims)
+	}
+}
@@ -0,0 +1,183 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mutations []string
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			body, _ := io.ReadAll(r.Body)
+			mutations = append(mutations, r.Method+" "+r.URL.RequestURI()+" "+strings.TrimSpace(string(body)))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"summary":"Old"}}`)
+	})
+	testMux.HandleFunc("/rest/api/3/group/user", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mutations = append(mutations, r.Method+" "+r.URL.RequestURI()+" "+strings.TrimSpace(string(body)))
+		w.WriteHeader(http.StatusCreated)
+	})
+	testMux.HandleFunc("/rest/api/3/project/EX/role/10002", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mutations = append(mutations, r.Method+" "+r.URL.RequestURI()+" "+strings.TrimSpace(string(body)))
+		fmt.Fprint(w, `{"id":10002,"actors":[]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Atlassian-Token"); got != "nocheck" {
+			t.Errorf("X-Atlassian-Token = %q", got)
+		}
+		_, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("FormFile() error = %v", err)
+			return
+		}
+		mutations = append(mutations, r.Method+" "+r.URL.RequestURI()+" "+header.Filename)
+		fmt.Fprint(w, `[{"id":"1","filename":"notes.txt"}]`)
+	})
+
+	ctx := context.Background()
+	testClient.DryRun = NewPlan()
+
+	// Reads reach Jira
+	issue, _, err := testClient.Issue.Get(ctx, "EX-1", nil)
+	if err != nil || issue.Fields.Summary != "Old" {
+		t.Fatalf("Get() = %+v, %v", issue, err)
+	}
+
+	// Writes are captured
+	if _, _, err := testClient.Issue.Update(ctx, &Issue{Key: "EX-1", Fields: &IssueFields{Summary: "New"}}, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if resp, err := testClient.Group.AddUserByGroupId(ctx, "1", "5b10ac8d82e05b22cc7d4ef5"); err != nil || resp.Header.Get(DryRunHeader) != "true" {
+		t.Fatalf("AddUserByGroupId() = %v, %v", resp, err)
+	}
+	if _, err := testClient.Role.RemoveUserFromRole(ctx, "EX", 10002, "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Fatalf("RemoveUserFromRole() error = %v", err)
+	}
+	if _, _, err := testClient.Issue.PostAttachment(ctx, "EX-1", strings.NewReader("notes"), "notes.txt"); err != nil {
+		t.Fatalf("PostAttachment() error = %v", err)
+	}
+	if len(mutations) != 0 {
+		t.Fatalf("mutations sent in dry-run mode: %v", mutations)
+	}
+
+	plan := testClient.DryRun
+	if plan.Len() != 4 {
+		t.Fatalf("Len() = %d, want 4", plan.Len())
+	}
+	if got := plan.Requests[0]; got.Operation != "Issue.Update" || got.Method != http.MethodPut || got.Path != "rest/api/2/issue/EX-1" || !strings.Contains(string(got.Body), `"summary":"New"`) {
+		t.Errorf("Requests[0] = %+v", got)
+	}
+	if got := plan.Requests[1]; got.Operation != "Group.AddUserByGroupId" || got.Path != "rest/api/3/group/user?groupId=1" || string(got.Body) != `{"accountId":"5b10ac8d82e05b22cc7d4ef5"}` {
+		t.Errorf("Requests[1] = %+v", got)
+	}
+	if got := plan.Requests[2]; got.Operation != "Role.RemoveUserFromRole" || got.Method != http.MethodDelete || got.Body != nil {
+		t.Errorf("Requests[2] = %+v", got)
+	}
+	if got := plan.Requests[3]; !strings.HasPrefix(got.ContentType, "multipart/form-data") || len(got.RawBody) == 0 {
+		t.Errorf("Requests[3] = %+v", got)
+	}
+
+	// The plan survives serialization and is applied for real
+	b, err := json.Marshal(plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed := NewPlan()
+	if err := json.Unmarshal(b, replayed); err != nil {
+		t.Fatal(err)
+	}
+	testClient.DryRun = nil
+	responses, err := replayed.Apply(ctx, testClient)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if len(responses) != 4 {
+		t.Errorf("Apply() returned %d responses, want 4", len(responses))
+	}
+	want := []string{
+		`PUT /rest/api/2/issue/EX-1 {"key":"EX-1","fields":{"summary":"New"}}`,
+		`POST /rest/api/3/group/user?groupId=1 {"accountId":"5b10ac8d82e05b22cc7d4ef5"}`,
+		`DELETE /rest/api/3/project/EX/role/10002?user=5b10ac8d82e05b22cc7d4ef5 `,
+		`POST /rest/api/2/issue/EX-1/attachments notes.txt`,
+	}
+	if strings.Join(mutations, "\n") != strings.Join(want, "\n") {
+		t.Errorf("applied mutations:\n%s\nwant:\n%s", strings.Join(mutations, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestPlan_Apply_StopsAtFailure(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages":[],"errors":{"summary":"Summary is required."}}`)
+	})
+
+	plan := &Plan{Requests: []PlannedRequest{
+		{Method: http.MethodPut, Path: "rest/api/2/issue/EX-1", Body: json.RawMessage(`{"fields":{"summary":""}}`)},
+		{Method: http.MethodDelete, Path: "rest/api/2/issue/EX-2"},
+	}}
+	responses, err := plan.Apply(context.Background(), testClient)
+	if err == nil || !strings.Contains(err.Error(), "Summary is required.") {
+		t.Errorf("Apply() error = %v", err)
+	}
+	if len(responses) != 1 || responses[0].StatusCode != http.StatusBadRequest {
+		t.Errorf("Apply() responses = %v", responses)
+	}
+}
+
+func TestClient_Do_DryRunHeaderFromJira(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		// A proxy or Jira itself may send the header, the response must be decoded nonetheless
+		w.Header().Set(DryRunHeader, "true")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1"}`)
+	})
+
+	issue, _, err := testClient.Issue.Get(context.Background(), "EX-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-1" {
+		t.Errorf("Expected the response to be decoded. Got %+v", issue)
+	}
+}
+
+func TestClient_Do_ResponseWithoutRequest(t *testing.T) {
+	// Custom transports and middlewares may return responses without their request
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"10002","key":"EX-1"}`)),
+		}, nil
+	})
+	client, err := NewClient("https://example.atlassian.net/", &http.Client{Transport: transport})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issue, _, err := client.Issue.Get(context.Background(), "EX-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-1" {
+		t.Errorf("Expected the response to be decoded. Got %+v", issue)
+	}
+}
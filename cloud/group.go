@@ -371,6 +371,17 @@ func (s *GroupService) Bulk(ctx context.Context, tweaks ...UserSearchF) ([]BulkG
 	return result.Values, resp, nil
 }
 
+// Sets the ids of the groups to get.
+func WithGroupIDs(ids []string) UserSearchF {
+	return func(s UserSearch) UserSearch {
+		for _, id := range ids {
+			s = append(s, UserSearchParam{name: "groupId", value: id})
+		}
+
+		return s
+	}
+}
+
 func WithInactiveUsers() UserSearchF {
 	return func(s UserSearch) UserSearch {
 		s = append(s, UserSearchParam{name: "includeInactiveUsers", value: "true"})
@@ -1,12 +1,10 @@
 package cloud
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"mime/multipart"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -677,44 +675,12 @@ func (s *IssueService) DownloadAttachment(ctx context.Context, attachmentID stri
 }
 
 // PostAttachment uploads r (io.Reader) as an attachment to a given issueID
+// The content of r is streamed to Jira, see PostAttachments for uploading several files at once.
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) PostAttachment(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/attachments", issueID)
-
-	b := new(bytes.Buffer)
-	writer := multipart.NewWriter(b)
-
-	fw, err := writer.CreateFormFile("file", attachmentName)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if r != nil {
-		// Copy the file
-		if _, err = io.Copy(fw, r); err != nil {
-			return nil, nil, err
-		}
-	}
-	writer.Close()
-
-	req, err := s.client.NewMultiPartRequest(ctx, http.MethodPost, apiEndpoint, b)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-
-	// PostAttachment response returns a JSON array (as multiple attachments can be posted)
-	attachment := new([]Attachment)
-	resp, err := s.client.Do(req, attachment)
-	if err != nil {
-		jerr := NewJiraError(resp, err)
-		return nil, resp, jerr
-	}
-
-	return attachment, resp, nil
+	return s.PostAttachments(ctx, issueID, []AttachmentFile{{Name: attachmentName, Reader: r}}, nil)
 }
 
 // DeleteAttachment deletes an attachment of a given attachmentID
@@ -816,26 +782,7 @@ func WithQueryOptions(options interface{}) func(*http.Request) error {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) Create(ctx context.Context, issue *Issue) (*Issue, *Response, error) {
-	apiEndpoint := "rest/api/2/issue"
-	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, issue)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := s.client.Do(req, nil)
-	if err != nil {
-		// incase of error return the resp for further inspection
-		return nil, resp, err
-	}
-	defer resp.Body.Close()
-
-	responseIssue := new(Issue)
-	err = json.NewDecoder(resp.Body).Decode(&responseIssue)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	return responseIssue, resp, nil
+	return s.create(ctx, issue)
 }
 
 // Update updates an issue from a JSON representation,
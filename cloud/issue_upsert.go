@@ -0,0 +1,202 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// DefaultExternalIDProperty is the key of the issue property which stores the external ID
+// of issues created by IssueService.UpsertByExternalID, unless configured otherwise.
+const DefaultExternalIDProperty = "externalId"
+
+// ErrAmbiguousExternalID is returned (wrapped) by IssueService.UpsertByExternalID
+// if more than one issue has the external ID.
+var ErrAmbiguousExternalID = errors.New("more than one issue has the external ID")
+
+// UpsertOptions configure where IssueService.UpsertByExternalID stores and looks up the external ID.
+type UpsertOptions struct {
+	// Property is the key of the issue property storing the external ID.
+	// It defaults to DefaultExternalIDProperty.
+	// The property must be indexed for JQL (issue.property[key].value), which requires
+	// the entity property module of an app.
+	Property string
+
+	// Field is the ID of a text custom field storing the external ID, like "customfield_10050".
+	// If set, it is used instead of Property.
+	Field string
+}
+
+// UpsertOption configures IssueService.UpsertByExternalID.
+type UpsertOption func(*UpsertOptions)
+
+// WithExternalIDProperty stores the external ID in the issue property with the given key.
+func WithExternalIDProperty(key string) UpsertOption {
+	return func(o *UpsertOptions) {
+		o.Property = key
+	}
+}
+
+// WithExternalIDField stores the external ID in the text custom field with the given ID, like "customfield_10050".
+func WithExternalIDField(fieldID string) UpsertOption {
+	return func(o *UpsertOptions) {
+		o.Field = fieldID
+	}
+}
+
+// UpsertResult is the outcome of IssueService.UpsertByExternalID.
+type UpsertResult struct {
+	// Issue is the created or updated issue, with its ID and key set.
+	Issue *Issue
+
+	// Created is true if the issue was created, and false if an existing issue was updated.
+	Created bool
+}
+
+// UpsertByExternalID updates the issue of the project which has the external ID,
+// or creates the issue if there is none. This makes retries of ticket syncs idempotent.
+//
+// The external ID is stored in an issue property (DefaultExternalIDProperty by default)
+// or in a text custom field, see WithExternalIDProperty and WithExternalIDField.
+// It is set in the same request which creates the issue, so an issue never exists without it.
+// Existing issues are looked up with JQL, therefore concurrent upserts of the same external ID
+// can still create duplicates; ErrAmbiguousExternalID is returned for them afterwards.
+//
+// The fields of issue are sent as is, except that the project is set on create and omitted on update.
+func (s *IssueService) UpsertByExternalID(ctx context.Context, project, externalID string, issue *Issue, options ...UpsertOption) (*UpsertResult, *Response, error) {
+	if externalID == "" {
+		return nil, nil, errors.New("external ID must not be empty")
+	}
+	if issue == nil || issue.Fields == nil {
+		return nil, nil, errors.New("issue fields must not be empty")
+	}
+	opts := UpsertOptions{Property: DefaultExternalIDProperty}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	existing, resp, err := s.findByExternalID(ctx, project, externalID, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	switch len(existing) {
+	case 0:
+		return s.createWithExternalID(ctx, project, externalID, issue, opts)
+	case 1:
+		update := *issue
+		fields := *issue.Fields
+		fields.Project = Project{}
+		update.ID, update.Key, update.Fields = existing[0].ID, existing[0].Key, &fields
+		updated, resp, err := s.Update(ctx, &update, nil)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &UpsertResult{Issue: updated}, resp, nil
+	default:
+		keys := make([]string, 0, len(existing))
+		for _, i := range existing {
+			keys = append(keys, i.Key)
+		}
+		return nil, resp, fmt.Errorf("%w: %s has issues %s", ErrAmbiguousExternalID, externalID, strings.Join(keys, ", "))
+	}
+}
+
+// findByExternalID returns the issues of the project with the external ID.
+func (s *IssueService) findByExternalID(ctx context.Context, project, externalID string, opts UpsertOptions) ([]Issue, *Response, error) {
+	jql := "project = " + quoteJQL(project) + " AND "
+	options := &SearchOptions{MaxResults: 50, Fields: []string{"key"}}
+	if opts.Field != "" {
+		// Text fields only support the CONTAINS operator, so exact matches are filtered below
+		jql += fmt.Sprintf("cf[%s] ~ %s", strings.TrimPrefix(opts.Field, "customfield_"), quoteJQL(`"`+externalID+`"`))
+		options.Fields = append(options.Fields, opts.Field)
+	} else {
+		jql += fmt.Sprintf("issue.property[%s].value = %s", quoteJQL(opts.Property), quoteJQL(externalID))
+	}
+
+	// CONTAINS also finds issues whose field merely contains the external ID,
+	// so all pages are read to not miss the exact match
+	var matches []Issue
+	for {
+		issues, resp, err := s.Search(ctx, jql, options)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, i := range issues {
+			if opts.Field == "" {
+				matches = append(matches, i)
+				continue
+			}
+			if i.Fields == nil {
+				continue
+			}
+			if value, ok := i.Fields.Unknowns[opts.Field].(string); ok && value == externalID {
+				matches = append(matches, i)
+			}
+		}
+		options.StartAt += len(issues)
+		if len(issues) == 0 || options.StartAt >= resp.Total {
+			return matches, resp, nil
+		}
+	}
+}
+
+// createWithExternalID creates the issue in the project with the external ID.
+func (s *IssueService) createWithExternalID(ctx context.Context, project, externalID string, issue *Issue, opts UpsertOptions) (*UpsertResult, *Response, error) {
+	create := *issue
+	fields := *issue.Fields
+	fields.Project = Project{Key: project}
+	create.Fields = &fields
+
+	var payload interface{} = &create
+	if opts.Field != "" {
+		fields.Unknowns = make(map[string]interface{}, len(issue.Fields.Unknowns)+1)
+		for k, v := range issue.Fields.Unknowns {
+			fields.Unknowns[k] = v
+		}
+		fields.Unknowns[opts.Field] = externalID
+	} else {
+		payload = struct {
+			*Issue
+			Properties []EntityProperty `json:"properties"`
+		}{&create, []EntityProperty{{Key: opts.Property, Value: externalID}}}
+	}
+
+	created, resp, err := s.create(ctx, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+	create.ID, create.Key, create.Self = created.ID, created.Key, created.Self
+	return &UpsertResult{Issue: &create, Created: true}, resp, nil
+}
+
+// create creates an issue from payload, which is an *Issue or a struct embedding it.
+func (s *IssueService) create(ctx context.Context, payload interface{}) (*Issue, *Response, error) {
+	apiEndpoint := "rest/api/2/issue"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		// incase of error return the resp for further inspection
+		return nil, resp, err
+	}
+	defer resp.Body.Close()
+
+	responseIssue := new(Issue)
+	err = json.NewDecoder(resp.Body).Decode(&responseIssue)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return responseIssue, resp, nil
+}
+
+// quoteJQL returns s as a quoted JQL string.
+func quoteJQL(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -117,3 +117,20 @@ func TestIssueLinkTypeService_Delete(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestIssueLinkTypeService_GetList_Wrapped(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issueLinkType", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"issueLinkTypes":[{"id":"10000","name":"Blocks","inward":"is blocked by","outward":"blocks"}]}`)
+	})
+
+	linkTypes, _, err := testClient.IssueLinkType.GetList(context.Background())
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(linkTypes) != 1 || linkTypes[0].Name != "Blocks" {
+		t.Errorf("Expected the issue link type Blocks. Got %+v", linkTypes)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -21,6 +22,8 @@ const (
 	defaultUserAgent = "go-jira" + "/" + ClientVersion
 )
 
+//go:generate go run ../internal/servicegen
+
 // A Client manages communication with the Jira API.
 type Client struct {
 	clientMu sync.Mutex   // clientMu protects the client during calls that modify it.
@@ -34,32 +37,62 @@ type Client struct {
 	// User agent used when communicating with the Jira API.
 	UserAgent string
 
+	// Cache caches the responses of rarely changing endpoints. Optional.
+	Cache *ResponseCache
+
+	// Logger logs the API requests of the client. Optional.
+	// Authorization headers, cookies, JWTs, tokens and passwords are redacted.
+	Logger *slog.Logger
+
+	// LogOptions configure the logging of requests.
+	LogOptions LogOptions
+
+	// DryRun enables the dry-run mode if set: POST, PUT and DELETE requests are not sent,
+	// but captured into the plan and answered with a synthetic successful response
+	// carrying the DryRunHeader. Other requests are sent as usual. Optional.
+	DryRun *Plan
+
+	// UserCache caches the users returned by the UserService, which looks up
+	// users by account ID in it first, and the account IDs resolved by UserService.GetAccountIDs.
+	// Other services don't use it, as the users they return, like group members and role actors, are incomplete.
+	// It can be shared by clients of the same Jira instance. Optional.
+	UserCache *UserCache
+
+	// middlewares wrap sending requests, see Use.
+	middlewares []Middleware
+
 	// Reuse a single struct instead of allocating one for each service on the heap.
 	common service
 
 	// Services used for talking to different parts of the Jira API.
-	Issue            *IssueService
-	Project          *ProjectService
-	Board            *BoardService
-	Sprint           *SprintService
-	User             *UserService
-	Group            *GroupService
-	Version          *VersionService
-	Priority         *PriorityService
-	Field            *FieldService
-	Component        *ComponentService
-	Resolution       *ResolutionService
-	StatusCategory   *StatusCategoryService
-	Filter           *FilterService
-	Role             *RoleService
-	PermissionScheme *PermissionSchemeService
-	Status           *StatusService
-	IssueLinkType    *IssueLinkTypeService
-	Organization     *OrganizationService
-	ServiceDesk      *ServiceDeskService
-	Customer         *CustomerService
-	Request          *RequestService
-	Audit            *AuditService
+	// They are interfaces, so they can be replaced by the mocks of the mocks package in tests.
+	Issue            IssueAPI
+	Project          ProjectAPI
+	Board            BoardAPI
+	Sprint           SprintAPI
+	User             UserAPI
+	Group            GroupAPI
+	Version          VersionAPI
+	Priority         PriorityAPI
+	Field            FieldAPI
+	Component        ComponentAPI
+	Resolution       ResolutionAPI
+	StatusCategory   StatusCategoryAPI
+	Filter           FilterAPI
+	Role             RoleAPI
+	PermissionScheme PermissionSchemeAPI
+	Status           StatusAPI
+	IssueLinkType    IssueLinkTypeAPI
+	Organization     OrganizationAPI
+	ServiceDesk      ServiceDeskAPI
+	Customer         CustomerAPI
+	Request          RequestAPI
+	Audit            AuditAPI
+	Webhook          WebhookAPI
+	ServerInfo       ServerInfoAPI
+	Property         PropertyAPI
+	ApplicationRole  ApplicationRoleAPI
+	ProjectCategory  ProjectCategoryAPI
 }
 
 // service is the base structure to bundle API services
@@ -123,6 +156,11 @@ func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
 	c.Customer = (*CustomerService)(&c.common)
 	c.Request = (*RequestService)(&c.common)
 	c.Audit = (*AuditService)(&c.common)
+	c.Webhook = (*WebhookService)(&c.common)
+	c.ServerInfo = (*ServerInfoService)(&c.common)
+	c.Property = (*PropertyService)(&c.common)
+	c.ApplicationRole = (*ApplicationRoleService)(&c.common)
+	c.ProjectCategory = (*ProjectCategoryService)(&c.common)
 
 	return c, nil
 }
@@ -209,8 +247,10 @@ func addOptions(s string, opts interface{}) (string, error) {
 
 // NewMultiPartRequest creates an API request including a multi-part file.
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
-// If specified, the value pointed to by buf is a multipart form.
-func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
+// If specified, buf provides the multipart form. It can be any io.Reader, which allows streaming the form
+// (the caller is responsible to set the ContentLength of the returned request for readers other than
+// *bytes.Buffer, *bytes.Reader and *strings.Reader).
+func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string, buf io.Reader) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -234,7 +274,7 @@ func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string,
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occurred.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	httpResp, err := c.client.Do(req)
+	httpResp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -249,7 +289,10 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 	if v != nil {
 		// Open a NewDecoder and defer closing the reader only if there is a provided interface to decode to
 		defer httpResp.Body.Close()
-		err = json.NewDecoder(httpResp.Body).Decode(v)
+		// The synthetic responses of the dry-run mode have no content to decode
+		if !isCaptured(httpResp) {
+			err = json.NewDecoder(httpResp.Body).Decode(v)
+		}
 	}
 
 	resp := newResponse(httpResp, v)
@@ -297,5 +340,9 @@ func (r *Response) populatePageValues(v interface{}) {
 		r.StartAt = value.StartAt
 		r.MaxResults = value.MaxResults
 		r.Total = value.Total
+	case *webhookListResult:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
 	}
 }
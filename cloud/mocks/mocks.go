@@ -0,0 +1,1478 @@
+// Code generated by servicegen. DO NOT EDIT.
+
+// Package mocks provides mocks of the services of the cloud package.
+//
+// A mock calls the function of the field named like the method with the suffix "Func".
+// Calling a method without a function panics.
+package mocks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/conductorone/go-jira/v2/cloud"
+)
+
+// ApplicationRoleService is a mock of cloud.ApplicationRoleAPI.
+type ApplicationRoleService struct {
+	GetFunc                func(context.Context, string) (*cloud.ApplicationRole, *cloud.Response, error)
+	GetListFunc            func(context.Context) ([]cloud.ApplicationRole, *cloud.Response, error)
+	LicenseUtilizationFunc func(context.Context) ([]cloud.LicenseUtilization, *cloud.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *ApplicationRoleService) Get(ctx context.Context, key string) (*cloud.ApplicationRole, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: ApplicationRoleService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, key)
+}
+
+// GetList calls GetListFunc.
+func (mock *ApplicationRoleService) GetList(ctx context.Context) ([]cloud.ApplicationRole, *cloud.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: ApplicationRoleService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// LicenseUtilization calls LicenseUtilizationFunc.
+func (mock *ApplicationRoleService) LicenseUtilization(ctx context.Context) ([]cloud.LicenseUtilization, *cloud.Response, error) {
+	if mock.LicenseUtilizationFunc == nil {
+		panic("mocks: ApplicationRoleService.LicenseUtilization is not implemented")
+	}
+	return mock.LicenseUtilizationFunc(ctx)
+}
+
+// AuditService is a mock of cloud.AuditAPI.
+type AuditService struct {
+	GetFunc func(context.Context, *cloud.AuditOptions) (*cloud.AuditResponse, *cloud.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *AuditService) Get(ctx context.Context, opts *cloud.AuditOptions) (*cloud.AuditResponse, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: AuditService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, opts)
+}
+
+// BoardService is a mock of cloud.BoardAPI.
+type BoardService struct {
+	CreateBoardFunc           func(context.Context, *cloud.Board) (*cloud.Board, *cloud.Response, error)
+	DeleteBoardFunc           func(context.Context, int) (*cloud.Board, *cloud.Response, error)
+	GetAllBoardsFunc          func(context.Context, *cloud.BoardListOptions) (*cloud.BoardsList, *cloud.Response, error)
+	GetAllSprintsFunc         func(context.Context, int64, *cloud.GetAllSprintsOptions) (*cloud.SprintsList, *cloud.Response, error)
+	GetBoardFunc              func(context.Context, int64) (*cloud.Board, *cloud.Response, error)
+	GetBoardConfigurationFunc func(context.Context, int) (*cloud.BoardConfiguration, *cloud.Response, error)
+}
+
+// CreateBoard calls CreateBoardFunc.
+func (mock *BoardService) CreateBoard(ctx context.Context, board *cloud.Board) (*cloud.Board, *cloud.Response, error) {
+	if mock.CreateBoardFunc == nil {
+		panic("mocks: BoardService.CreateBoard is not implemented")
+	}
+	return mock.CreateBoardFunc(ctx, board)
+}
+
+// DeleteBoard calls DeleteBoardFunc.
+func (mock *BoardService) DeleteBoard(ctx context.Context, boardID int) (*cloud.Board, *cloud.Response, error) {
+	if mock.DeleteBoardFunc == nil {
+		panic("mocks: BoardService.DeleteBoard is not implemented")
+	}
+	return mock.DeleteBoardFunc(ctx, boardID)
+}
+
+// GetAllBoards calls GetAllBoardsFunc.
+func (mock *BoardService) GetAllBoards(ctx context.Context, opt *cloud.BoardListOptions) (*cloud.BoardsList, *cloud.Response, error) {
+	if mock.GetAllBoardsFunc == nil {
+		panic("mocks: BoardService.GetAllBoards is not implemented")
+	}
+	return mock.GetAllBoardsFunc(ctx, opt)
+}
+
+// GetAllSprints calls GetAllSprintsFunc.
+func (mock *BoardService) GetAllSprints(ctx context.Context, boardID int64, options *cloud.GetAllSprintsOptions) (*cloud.SprintsList, *cloud.Response, error) {
+	if mock.GetAllSprintsFunc == nil {
+		panic("mocks: BoardService.GetAllSprints is not implemented")
+	}
+	return mock.GetAllSprintsFunc(ctx, boardID, options)
+}
+
+// GetBoard calls GetBoardFunc.
+func (mock *BoardService) GetBoard(ctx context.Context, boardID int64) (*cloud.Board, *cloud.Response, error) {
+	if mock.GetBoardFunc == nil {
+		panic("mocks: BoardService.GetBoard is not implemented")
+	}
+	return mock.GetBoardFunc(ctx, boardID)
+}
+
+// GetBoardConfiguration calls GetBoardConfigurationFunc.
+func (mock *BoardService) GetBoardConfiguration(ctx context.Context, boardID int) (*cloud.BoardConfiguration, *cloud.Response, error) {
+	if mock.GetBoardConfigurationFunc == nil {
+		panic("mocks: BoardService.GetBoardConfiguration is not implemented")
+	}
+	return mock.GetBoardConfigurationFunc(ctx, boardID)
+}
+
+// ComponentService is a mock of cloud.ComponentAPI.
+type ComponentService struct {
+	CreateFunc func(context.Context, *cloud.ComponentCreateOptions) (*cloud.ProjectComponent, *cloud.Response, error)
+	GetFunc    func(context.Context, string) (*cloud.ProjectComponent, *cloud.Response, error)
+	UpdateFunc func(context.Context, string, *cloud.ComponentCreateOptions) (*cloud.ProjectComponent, *cloud.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *ComponentService) Create(ctx context.Context, options *cloud.ComponentCreateOptions) (*cloud.ProjectComponent, *cloud.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: ComponentService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, options)
+}
+
+// Get calls GetFunc.
+func (mock *ComponentService) Get(ctx context.Context, componentID string) (*cloud.ProjectComponent, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: ComponentService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, componentID)
+}
+
+// Update calls UpdateFunc.
+func (mock *ComponentService) Update(ctx context.Context, componentID string, options *cloud.ComponentCreateOptions) (*cloud.ProjectComponent, *cloud.Response, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: ComponentService.Update is not implemented")
+	}
+	return mock.UpdateFunc(ctx, componentID, options)
+}
+
+// CustomerService is a mock of cloud.CustomerAPI.
+type CustomerService struct {
+	CreateFunc func(context.Context, string, string) (*cloud.Customer, *cloud.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *CustomerService) Create(ctx context.Context, email string, displayName string) (*cloud.Customer, *cloud.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: CustomerService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, email, displayName)
+}
+
+// FieldService is a mock of cloud.FieldAPI.
+type FieldService struct {
+	GetListFunc func(context.Context) ([]cloud.Field, *cloud.Response, error)
+}
+
+// GetList calls GetListFunc.
+func (mock *FieldService) GetList(ctx context.Context) ([]cloud.Field, *cloud.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: FieldService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// FilterService is a mock of cloud.FilterAPI.
+type FilterService struct {
+	GetFunc              func(context.Context, int) (*cloud.Filter, *cloud.Response, error)
+	GetFavouriteListFunc func(context.Context) ([]*cloud.Filter, *cloud.Response, error)
+	GetListFunc          func(context.Context) ([]*cloud.Filter, *cloud.Response, error)
+	GetMyFiltersFunc     func(context.Context, *cloud.GetMyFiltersQueryOptions) ([]*cloud.Filter, *cloud.Response, error)
+	SearchFunc           func(context.Context, *cloud.FilterSearchOptions) (*cloud.FiltersList, *cloud.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *FilterService) Get(ctx context.Context, filterID int) (*cloud.Filter, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: FilterService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, filterID)
+}
+
+// GetFavouriteList calls GetFavouriteListFunc.
+func (mock *FilterService) GetFavouriteList(ctx context.Context) ([]*cloud.Filter, *cloud.Response, error) {
+	if mock.GetFavouriteListFunc == nil {
+		panic("mocks: FilterService.GetFavouriteList is not implemented")
+	}
+	return mock.GetFavouriteListFunc(ctx)
+}
+
+// GetList calls GetListFunc.
+func (mock *FilterService) GetList(ctx context.Context) ([]*cloud.Filter, *cloud.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: FilterService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// GetMyFilters calls GetMyFiltersFunc.
+func (mock *FilterService) GetMyFilters(ctx context.Context, opts *cloud.GetMyFiltersQueryOptions) ([]*cloud.Filter, *cloud.Response, error) {
+	if mock.GetMyFiltersFunc == nil {
+		panic("mocks: FilterService.GetMyFilters is not implemented")
+	}
+	return mock.GetMyFiltersFunc(ctx, opts)
+}
+
+// Search calls SearchFunc.
+func (mock *FilterService) Search(ctx context.Context, opt *cloud.FilterSearchOptions) (*cloud.FiltersList, *cloud.Response, error) {
+	if mock.SearchFunc == nil {
+		panic("mocks: FilterService.Search is not implemented")
+	}
+	return mock.SearchFunc(ctx, opt)
+}
+
+// GroupService is a mock of cloud.GroupAPI.
+type GroupService struct {
+	AddUserByGroupIdFunc      func(context.Context, string, string) (*cloud.Response, error)
+	AddUserByGroupNameFunc    func(context.Context, string, string) (*cloud.Group, *cloud.Response, error)
+	BulkFunc                  func(context.Context, ...cloud.UserSearchF) ([]cloud.BulkGroup, *cloud.Response, error)
+	FindFunc                  func(context.Context, ...cloud.UserSearchF) ([]cloud.Group, *cloud.Response, error)
+	GetFunc                   func(context.Context, string, *cloud.GroupSearchOptions) ([]cloud.GroupMember, *cloud.Response, error)
+	GetGroupMembersFunc       func(context.Context, string, ...cloud.UserSearchF) ([]cloud.GroupMember, *cloud.Response, error)
+	RemoveUserByGroupIdFunc   func(context.Context, string, string) (*cloud.Response, error)
+	RemoveUserByGroupNameFunc func(context.Context, string, string) (*cloud.Response, error)
+}
+
+// AddUserByGroupId calls AddUserByGroupIdFunc.
+func (mock *GroupService) AddUserByGroupId(ctx context.Context, groupId string, accountID string) (*cloud.Response, error) {
+	if mock.AddUserByGroupIdFunc == nil {
+		panic("mocks: GroupService.AddUserByGroupId is not implemented")
+	}
+	return mock.AddUserByGroupIdFunc(ctx, groupId, accountID)
+}
+
+// AddUserByGroupName calls AddUserByGroupNameFunc.
+func (mock *GroupService) AddUserByGroupName(ctx context.Context, groupName string, accountID string) (*cloud.Group, *cloud.Response, error) {
+	if mock.AddUserByGroupNameFunc == nil {
+		panic("mocks: GroupService.AddUserByGroupName is not implemented")
+	}
+	return mock.AddUserByGroupNameFunc(ctx, groupName, accountID)
+}
+
+// Bulk calls BulkFunc.
+func (mock *GroupService) Bulk(ctx context.Context, tweaks ...cloud.UserSearchF) ([]cloud.BulkGroup, *cloud.Response, error) {
+	if mock.BulkFunc == nil {
+		panic("mocks: GroupService.Bulk is not implemented")
+	}
+	return mock.BulkFunc(ctx, tweaks...)
+}
+
+// Find calls FindFunc.
+func (mock *GroupService) Find(ctx context.Context, tweaks ...cloud.UserSearchF) ([]cloud.Group, *cloud.Response, error) {
+	if mock.FindFunc == nil {
+		panic("mocks: GroupService.Find is not implemented")
+	}
+	return mock.FindFunc(ctx, tweaks...)
+}
+
+// Get calls GetFunc.
+func (mock *GroupService) Get(ctx context.Context, name string, options *cloud.GroupSearchOptions) ([]cloud.GroupMember, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: GroupService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, name, options)
+}
+
+// GetGroupMembers calls GetGroupMembersFunc.
+func (mock *GroupService) GetGroupMembers(ctx context.Context, groupId string, tweaks ...cloud.UserSearchF) ([]cloud.GroupMember, *cloud.Response, error) {
+	if mock.GetGroupMembersFunc == nil {
+		panic("mocks: GroupService.GetGroupMembers is not implemented")
+	}
+	return mock.GetGroupMembersFunc(ctx, groupId, tweaks...)
+}
+
+// RemoveUserByGroupId calls RemoveUserByGroupIdFunc.
+func (mock *GroupService) RemoveUserByGroupId(ctx context.Context, groupId string, accountID string) (*cloud.Response, error) {
+	if mock.RemoveUserByGroupIdFunc == nil {
+		panic("mocks: GroupService.RemoveUserByGroupId is not implemented")
+	}
+	return mock.RemoveUserByGroupIdFunc(ctx, groupId, accountID)
+}
+
+// RemoveUserByGroupName calls RemoveUserByGroupNameFunc.
+func (mock *GroupService) RemoveUserByGroupName(ctx context.Context, groupName string, accountID string) (*cloud.Response, error) {
+	if mock.RemoveUserByGroupNameFunc == nil {
+		panic("mocks: GroupService.RemoveUserByGroupName is not implemented")
+	}
+	return mock.RemoveUserByGroupNameFunc(ctx, groupName, accountID)
+}
+
+// IssueLinkTypeService is a mock of cloud.IssueLinkTypeAPI.
+type IssueLinkTypeService struct {
+	CreateFunc  func(context.Context, *cloud.IssueLinkType) (*cloud.IssueLinkType, *cloud.Response, error)
+	DeleteFunc  func(context.Context, string) (*cloud.Response, error)
+	GetFunc     func(context.Context, string) (*cloud.IssueLinkType, *cloud.Response, error)
+	GetListFunc func(context.Context) ([]cloud.IssueLinkType, *cloud.Response, error)
+	UpdateFunc  func(context.Context, *cloud.IssueLinkType) (*cloud.IssueLinkType, *cloud.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *IssueLinkTypeService) Create(ctx context.Context, linkType *cloud.IssueLinkType) (*cloud.IssueLinkType, *cloud.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: IssueLinkTypeService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, linkType)
+}
+
+// Delete calls DeleteFunc.
+func (mock *IssueLinkTypeService) Delete(ctx context.Context, ID string) (*cloud.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: IssueLinkTypeService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, ID)
+}
+
+// Get calls GetFunc.
+func (mock *IssueLinkTypeService) Get(ctx context.Context, ID string) (*cloud.IssueLinkType, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: IssueLinkTypeService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, ID)
+}
+
+// GetList calls GetListFunc.
+func (mock *IssueLinkTypeService) GetList(ctx context.Context) ([]cloud.IssueLinkType, *cloud.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: IssueLinkTypeService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// Update calls UpdateFunc.
+func (mock *IssueLinkTypeService) Update(ctx context.Context, linkType *cloud.IssueLinkType) (*cloud.IssueLinkType, *cloud.Response, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: IssueLinkTypeService.Update is not implemented")
+	}
+	return mock.UpdateFunc(ctx, linkType)
+}
+
+// IssueService is a mock of cloud.IssueAPI.
+type IssueService struct {
+	AddCommentFunc              func(context.Context, string, *cloud.Comment) (*cloud.Comment, *cloud.Response, error)
+	AddLinkFunc                 func(context.Context, *cloud.IssueLink) (*cloud.Response, error)
+	AddRemoteLinkFunc           func(context.Context, string, *cloud.RemoteLink) (*cloud.RemoteLink, *cloud.Response, error)
+	AddWatcherFunc              func(context.Context, string, string) (*cloud.Response, error)
+	AddWorklogRecordFunc        func(context.Context, string, *cloud.WorklogRecord, ...func(*http.Request) error) (*cloud.WorklogRecord, *cloud.Response, error)
+	CreateFunc                  func(context.Context, *cloud.Issue) (*cloud.Issue, *cloud.Response, error)
+	DeleteFunc                  func(context.Context, string) (*cloud.Response, error)
+	DeleteAttachmentFunc        func(context.Context, string) (*cloud.Response, error)
+	DeleteCommentFunc           func(context.Context, string, string) error
+	DeleteLinkFunc              func(context.Context, string) (*cloud.Response, error)
+	DoTransitionFunc            func(context.Context, string, string) (*cloud.Response, error)
+	DoTransitionWithPayloadFunc func(context.Context, interface{}, interface{}) (*cloud.Response, error)
+	DownloadAttachmentFunc      func(context.Context, string) (*cloud.Response, error)
+	DownloadAttachmentToFunc    func(context.Context, string, io.Writer, *cloud.DownloadAttachmentOptions) (*cloud.Attachment, *cloud.Response, error)
+	GetFunc                     func(context.Context, string, *cloud.GetQueryOptions) (*cloud.Issue, *cloud.Response, error)
+	GetAttachmentFunc           func(context.Context, string) (*cloud.Attachment, *cloud.Response, error)
+	GetCreateMetaFunc           func(context.Context, *cloud.GetQueryOptions) (*cloud.CreateMetaInfo, *cloud.Response, error)
+	GetCreateMetaIssueTypeFunc  func(context.Context, string, string, *cloud.GetQueryIssueTypeOptions) ([]*cloud.MetaDataFields, *cloud.Response, error)
+	GetCustomFieldsFunc         func(context.Context, string) (cloud.CustomFields, *cloud.Response, error)
+	GetEditMetaFunc             func(context.Context, *cloud.Issue) (*cloud.EditMetaInfo, *cloud.Response, error)
+	GetRemoteLinksFunc          func(context.Context, string) (*[]cloud.RemoteLink, *cloud.Response, error)
+	GetTransitionsFunc          func(context.Context, string) ([]cloud.Transition, *cloud.Response, error)
+	GetWatchersFunc             func(context.Context, string) (*[]cloud.User, *cloud.Response, error)
+	GetWorklogsFunc             func(context.Context, string, ...func(*http.Request) error) (*cloud.Worklog, *cloud.Response, error)
+	PostAttachmentFunc          func(context.Context, string, io.Reader, string) (*[]cloud.Attachment, *cloud.Response, error)
+	PostAttachmentsFunc         func(context.Context, string, []cloud.AttachmentFile, *cloud.PostAttachmentOptions) (*[]cloud.Attachment, *cloud.Response, error)
+	RemoveWatcherFunc           func(context.Context, string, string) (*cloud.Response, error)
+	SearchFunc                  func(context.Context, string, *cloud.SearchOptions) ([]cloud.Issue, *cloud.Response, error)
+	SearchPagesFunc             func(context.Context, string, *cloud.SearchOptions, func(cloud.Issue) error) error
+	UpdateFunc                  func(context.Context, *cloud.Issue, *cloud.UpdateQueryOptions) (*cloud.Issue, *cloud.Response, error)
+	UpdateAssigneeFunc          func(context.Context, string, *cloud.User) (*cloud.Response, error)
+	UpdateCommentFunc           func(context.Context, string, *cloud.Comment) (*cloud.Comment, *cloud.Response, error)
+	UpdateIssueFunc             func(context.Context, string, map[string]interface{}) (*cloud.Response, error)
+	UpdateRemoteLinkFunc        func(context.Context, string, int, *cloud.RemoteLink) (*cloud.Response, error)
+	UpdateWorklogRecordFunc     func(context.Context, string, string, *cloud.WorklogRecord, ...func(*http.Request) error) (*cloud.WorklogRecord, *cloud.Response, error)
+	UpsertByExternalIDFunc      func(context.Context, string, string, *cloud.Issue, ...cloud.UpsertOption) (*cloud.UpsertResult, *cloud.Response, error)
+}
+
+// AddComment calls AddCommentFunc.
+func (mock *IssueService) AddComment(ctx context.Context, issueID string, comment *cloud.Comment) (*cloud.Comment, *cloud.Response, error) {
+	if mock.AddCommentFunc == nil {
+		panic("mocks: IssueService.AddComment is not implemented")
+	}
+	return mock.AddCommentFunc(ctx, issueID, comment)
+}
+
+// AddLink calls AddLinkFunc.
+func (mock *IssueService) AddLink(ctx context.Context, issueLink *cloud.IssueLink) (*cloud.Response, error) {
+	if mock.AddLinkFunc == nil {
+		panic("mocks: IssueService.AddLink is not implemented")
+	}
+	return mock.AddLinkFunc(ctx, issueLink)
+}
+
+// AddRemoteLink calls AddRemoteLinkFunc.
+func (mock *IssueService) AddRemoteLink(ctx context.Context, issueID string, remotelink *cloud.RemoteLink) (*cloud.RemoteLink, *cloud.Response, error) {
+	if mock.AddRemoteLinkFunc == nil {
+		panic("mocks: IssueService.AddRemoteLink is not implemented")
+	}
+	return mock.AddRemoteLinkFunc(ctx, issueID, remotelink)
+}
+
+// AddWatcher calls AddWatcherFunc.
+func (mock *IssueService) AddWatcher(ctx context.Context, issueID string, userName string) (*cloud.Response, error) {
+	if mock.AddWatcherFunc == nil {
+		panic("mocks: IssueService.AddWatcher is not implemented")
+	}
+	return mock.AddWatcherFunc(ctx, issueID, userName)
+}
+
+// AddWorklogRecord calls AddWorklogRecordFunc.
+func (mock *IssueService) AddWorklogRecord(ctx context.Context, issueID string, record *cloud.WorklogRecord, options ...func(*http.Request) error) (*cloud.WorklogRecord, *cloud.Response, error) {
+	if mock.AddWorklogRecordFunc == nil {
+		panic("mocks: IssueService.AddWorklogRecord is not implemented")
+	}
+	return mock.AddWorklogRecordFunc(ctx, issueID, record, options...)
+}
+
+// Create calls CreateFunc.
+func (mock *IssueService) Create(ctx context.Context, issue *cloud.Issue) (*cloud.Issue, *cloud.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: IssueService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, issue)
+}
+
+// Delete calls DeleteFunc.
+func (mock *IssueService) Delete(ctx context.Context, issueID string) (*cloud.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: IssueService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, issueID)
+}
+
+// DeleteAttachment calls DeleteAttachmentFunc.
+func (mock *IssueService) DeleteAttachment(ctx context.Context, attachmentID string) (*cloud.Response, error) {
+	if mock.DeleteAttachmentFunc == nil {
+		panic("mocks: IssueService.DeleteAttachment is not implemented")
+	}
+	return mock.DeleteAttachmentFunc(ctx, attachmentID)
+}
+
+// DeleteComment calls DeleteCommentFunc.
+func (mock *IssueService) DeleteComment(ctx context.Context, issueID string, commentID string) error {
+	if mock.DeleteCommentFunc == nil {
+		panic("mocks: IssueService.DeleteComment is not implemented")
+	}
+	return mock.DeleteCommentFunc(ctx, issueID, commentID)
+}
+
+// DeleteLink calls DeleteLinkFunc.
+func (mock *IssueService) DeleteLink(ctx context.Context, linkID string) (*cloud.Response, error) {
+	if mock.DeleteLinkFunc == nil {
+		panic("mocks: IssueService.DeleteLink is not implemented")
+	}
+	return mock.DeleteLinkFunc(ctx, linkID)
+}
+
+// DoTransition calls DoTransitionFunc.
+func (mock *IssueService) DoTransition(ctx context.Context, ticketID string, transitionID string) (*cloud.Response, error) {
+	if mock.DoTransitionFunc == nil {
+		panic("mocks: IssueService.DoTransition is not implemented")
+	}
+	return mock.DoTransitionFunc(ctx, ticketID, transitionID)
+}
+
+// DoTransitionWithPayload calls DoTransitionWithPayloadFunc.
+func (mock *IssueService) DoTransitionWithPayload(ctx context.Context, ticketID interface{}, payload interface{}) (*cloud.Response, error) {
+	if mock.DoTransitionWithPayloadFunc == nil {
+		panic("mocks: IssueService.DoTransitionWithPayload is not implemented")
+	}
+	return mock.DoTransitionWithPayloadFunc(ctx, ticketID, payload)
+}
+
+// DownloadAttachment calls DownloadAttachmentFunc.
+func (mock *IssueService) DownloadAttachment(ctx context.Context, attachmentID string) (*cloud.Response, error) {
+	if mock.DownloadAttachmentFunc == nil {
+		panic("mocks: IssueService.DownloadAttachment is not implemented")
+	}
+	return mock.DownloadAttachmentFunc(ctx, attachmentID)
+}
+
+// DownloadAttachmentTo calls DownloadAttachmentToFunc.
+func (mock *IssueService) DownloadAttachmentTo(ctx context.Context, attachmentID string, w io.Writer, options *cloud.DownloadAttachmentOptions) (*cloud.Attachment, *cloud.Response, error) {
+	if mock.DownloadAttachmentToFunc == nil {
+		panic("mocks: IssueService.DownloadAttachmentTo is not implemented")
+	}
+	return mock.DownloadAttachmentToFunc(ctx, attachmentID, w, options)
+}
+
+// Get calls GetFunc.
+func (mock *IssueService) Get(ctx context.Context, issueID string, options *cloud.GetQueryOptions) (*cloud.Issue, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: IssueService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, issueID, options)
+}
+
+// GetAttachment calls GetAttachmentFunc.
+func (mock *IssueService) GetAttachment(ctx context.Context, attachmentID string) (*cloud.Attachment, *cloud.Response, error) {
+	if mock.GetAttachmentFunc == nil {
+		panic("mocks: IssueService.GetAttachment is not implemented")
+	}
+	return mock.GetAttachmentFunc(ctx, attachmentID)
+}
+
+// GetCreateMeta calls GetCreateMetaFunc.
+func (mock *IssueService) GetCreateMeta(ctx context.Context, options *cloud.GetQueryOptions) (*cloud.CreateMetaInfo, *cloud.Response, error) {
+	if mock.GetCreateMetaFunc == nil {
+		panic("mocks: IssueService.GetCreateMeta is not implemented")
+	}
+	return mock.GetCreateMetaFunc(ctx, options)
+}
+
+// GetCreateMetaIssueType calls GetCreateMetaIssueTypeFunc.
+func (mock *IssueService) GetCreateMetaIssueType(ctx context.Context, projectKey string, issueTypeId string, options *cloud.GetQueryIssueTypeOptions) ([]*cloud.MetaDataFields, *cloud.Response, error) {
+	if mock.GetCreateMetaIssueTypeFunc == nil {
+		panic("mocks: IssueService.GetCreateMetaIssueType is not implemented")
+	}
+	return mock.GetCreateMetaIssueTypeFunc(ctx, projectKey, issueTypeId, options)
+}
+
+// GetCustomFields calls GetCustomFieldsFunc.
+func (mock *IssueService) GetCustomFields(ctx context.Context, issueID string) (cloud.CustomFields, *cloud.Response, error) {
+	if mock.GetCustomFieldsFunc == nil {
+		panic("mocks: IssueService.GetCustomFields is not implemented")
+	}
+	return mock.GetCustomFieldsFunc(ctx, issueID)
+}
+
+// GetEditMeta calls GetEditMetaFunc.
+func (mock *IssueService) GetEditMeta(ctx context.Context, issue *cloud.Issue) (*cloud.EditMetaInfo, *cloud.Response, error) {
+	if mock.GetEditMetaFunc == nil {
+		panic("mocks: IssueService.GetEditMeta is not implemented")
+	}
+	return mock.GetEditMetaFunc(ctx, issue)
+}
+
+// GetRemoteLinks calls GetRemoteLinksFunc.
+func (mock *IssueService) GetRemoteLinks(ctx context.Context, id string) (*[]cloud.RemoteLink, *cloud.Response, error) {
+	if mock.GetRemoteLinksFunc == nil {
+		panic("mocks: IssueService.GetRemoteLinks is not implemented")
+	}
+	return mock.GetRemoteLinksFunc(ctx, id)
+}
+
+// GetTransitions calls GetTransitionsFunc.
+func (mock *IssueService) GetTransitions(ctx context.Context, id string) ([]cloud.Transition, *cloud.Response, error) {
+	if mock.GetTransitionsFunc == nil {
+		panic("mocks: IssueService.GetTransitions is not implemented")
+	}
+	return mock.GetTransitionsFunc(ctx, id)
+}
+
+// GetWatchers calls GetWatchersFunc.
+func (mock *IssueService) GetWatchers(ctx context.Context, issueID string) (*[]cloud.User, *cloud.Response, error) {
+	if mock.GetWatchersFunc == nil {
+		panic("mocks: IssueService.GetWatchers is not implemented")
+	}
+	return mock.GetWatchersFunc(ctx, issueID)
+}
+
+// GetWorklogs calls GetWorklogsFunc.
+func (mock *IssueService) GetWorklogs(ctx context.Context, issueID string, options ...func(*http.Request) error) (*cloud.Worklog, *cloud.Response, error) {
+	if mock.GetWorklogsFunc == nil {
+		panic("mocks: IssueService.GetWorklogs is not implemented")
+	}
+	return mock.GetWorklogsFunc(ctx, issueID, options...)
+}
+
+// PostAttachment calls PostAttachmentFunc.
+func (mock *IssueService) PostAttachment(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]cloud.Attachment, *cloud.Response, error) {
+	if mock.PostAttachmentFunc == nil {
+		panic("mocks: IssueService.PostAttachment is not implemented")
+	}
+	return mock.PostAttachmentFunc(ctx, issueID, r, attachmentName)
+}
+
+// PostAttachments calls PostAttachmentsFunc.
+func (mock *IssueService) PostAttachments(ctx context.Context, issueID string, files []cloud.AttachmentFile, options *cloud.PostAttachmentOptions) (*[]cloud.Attachment, *cloud.Response, error) {
+	if mock.PostAttachmentsFunc == nil {
+		panic("mocks: IssueService.PostAttachments is not implemented")
+	}
+	return mock.PostAttachmentsFunc(ctx, issueID, files, options)
+}
+
+// RemoveWatcher calls RemoveWatcherFunc.
+func (mock *IssueService) RemoveWatcher(ctx context.Context, issueID string, userName string) (*cloud.Response, error) {
+	if mock.RemoveWatcherFunc == nil {
+		panic("mocks: IssueService.RemoveWatcher is not implemented")
+	}
+	return mock.RemoveWatcherFunc(ctx, issueID, userName)
+}
+
+// Search calls SearchFunc.
+func (mock *IssueService) Search(ctx context.Context, jql string, options *cloud.SearchOptions) ([]cloud.Issue, *cloud.Response, error) {
+	if mock.SearchFunc == nil {
+		panic("mocks: IssueService.Search is not implemented")
+	}
+	return mock.SearchFunc(ctx, jql, options)
+}
+
+// SearchPages calls SearchPagesFunc.
+func (mock *IssueService) SearchPages(ctx context.Context, jql string, options *cloud.SearchOptions, f func(cloud.Issue) error) error {
+	if mock.SearchPagesFunc == nil {
+		panic("mocks: IssueService.SearchPages is not implemented")
+	}
+	return mock.SearchPagesFunc(ctx, jql, options, f)
+}
+
+// Update calls UpdateFunc.
+func (mock *IssueService) Update(ctx context.Context, issue *cloud.Issue, opts *cloud.UpdateQueryOptions) (*cloud.Issue, *cloud.Response, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: IssueService.Update is not implemented")
+	}
+	return mock.UpdateFunc(ctx, issue, opts)
+}
+
+// UpdateAssignee calls UpdateAssigneeFunc.
+func (mock *IssueService) UpdateAssignee(ctx context.Context, issueID string, assignee *cloud.User) (*cloud.Response, error) {
+	if mock.UpdateAssigneeFunc == nil {
+		panic("mocks: IssueService.UpdateAssignee is not implemented")
+	}
+	return mock.UpdateAssigneeFunc(ctx, issueID, assignee)
+}
+
+// UpdateComment calls UpdateCommentFunc.
+func (mock *IssueService) UpdateComment(ctx context.Context, issueID string, comment *cloud.Comment) (*cloud.Comment, *cloud.Response, error) {
+	if mock.UpdateCommentFunc == nil {
+		panic("mocks: IssueService.UpdateComment is not implemented")
+	}
+	return mock.UpdateCommentFunc(ctx, issueID, comment)
+}
+
+// UpdateIssue calls UpdateIssueFunc.
+func (mock *IssueService) UpdateIssue(ctx context.Context, jiraID string, data map[string]interface{}) (*cloud.Response, error) {
+	if mock.UpdateIssueFunc == nil {
+		panic("mocks: IssueService.UpdateIssue is not implemented")
+	}
+	return mock.UpdateIssueFunc(ctx, jiraID, data)
+}
+
+// UpdateRemoteLink calls UpdateRemoteLinkFunc.
+func (mock *IssueService) UpdateRemoteLink(ctx context.Context, issueID string, linkID int, remotelink *cloud.RemoteLink) (*cloud.Response, error) {
+	if mock.UpdateRemoteLinkFunc == nil {
+		panic("mocks: IssueService.UpdateRemoteLink is not implemented")
+	}
+	return mock.UpdateRemoteLinkFunc(ctx, issueID, linkID, remotelink)
+}
+
+// UpdateWorklogRecord calls UpdateWorklogRecordFunc.
+func (mock *IssueService) UpdateWorklogRecord(ctx context.Context, issueID string, worklogID string, record *cloud.WorklogRecord, options ...func(*http.Request) error) (*cloud.WorklogRecord, *cloud.Response, error) {
+	if mock.UpdateWorklogRecordFunc == nil {
+		panic("mocks: IssueService.UpdateWorklogRecord is not implemented")
+	}
+	return mock.UpdateWorklogRecordFunc(ctx, issueID, worklogID, record, options...)
+}
+
+// UpsertByExternalID calls UpsertByExternalIDFunc.
+func (mock *IssueService) UpsertByExternalID(ctx context.Context, project string, externalID string, issue *cloud.Issue, options ...cloud.UpsertOption) (*cloud.UpsertResult, *cloud.Response, error) {
+	if mock.UpsertByExternalIDFunc == nil {
+		panic("mocks: IssueService.UpsertByExternalID is not implemented")
+	}
+	return mock.UpsertByExternalIDFunc(ctx, project, externalID, issue, options...)
+}
+
+// OrganizationService is a mock of cloud.OrganizationAPI.
+type OrganizationService struct {
+	AddUsersFunc            func(context.Context, int, cloud.OrganizationUsersDTO) (*cloud.Response, error)
+	CreateOrganizationFunc  func(context.Context, string) (*cloud.Organization, *cloud.Response, error)
+	DeleteOrganizationFunc  func(context.Context, int) (*cloud.Response, error)
+	DeletePropertyFunc      func(context.Context, int, string) (*cloud.Response, error)
+	GetAllOrganizationsFunc func(context.Context, int, int, string) (*cloud.PagedDTO, *cloud.Response, error)
+	GetOrganizationFunc     func(context.Context, int) (*cloud.Organization, *cloud.Response, error)
+	GetPropertiesKeysFunc   func(context.Context, int) (*cloud.PropertyKeys, *cloud.Response, error)
+	GetPropertyFunc         func(context.Context, int, string) (*cloud.EntityProperty, *cloud.Response, error)
+	GetUsersFunc            func(context.Context, int, int, int) (*cloud.PagedDTO, *cloud.Response, error)
+	RemoveUsersFunc         func(context.Context, int, cloud.OrganizationUsersDTO) (*cloud.Response, error)
+	SetPropertyFunc         func(context.Context, int, string, interface{}) (*cloud.Response, error)
+}
+
+// AddUsers calls AddUsersFunc.
+func (mock *OrganizationService) AddUsers(ctx context.Context, organizationID int, users cloud.OrganizationUsersDTO) (*cloud.Response, error) {
+	if mock.AddUsersFunc == nil {
+		panic("mocks: OrganizationService.AddUsers is not implemented")
+	}
+	return mock.AddUsersFunc(ctx, organizationID, users)
+}
+
+// CreateOrganization calls CreateOrganizationFunc.
+func (mock *OrganizationService) CreateOrganization(ctx context.Context, name string) (*cloud.Organization, *cloud.Response, error) {
+	if mock.CreateOrganizationFunc == nil {
+		panic("mocks: OrganizationService.CreateOrganization is not implemented")
+	}
+	return mock.CreateOrganizationFunc(ctx, name)
+}
+
+// DeleteOrganization calls DeleteOrganizationFunc.
+func (mock *OrganizationService) DeleteOrganization(ctx context.Context, organizationID int) (*cloud.Response, error) {
+	if mock.DeleteOrganizationFunc == nil {
+		panic("mocks: OrganizationService.DeleteOrganization is not implemented")
+	}
+	return mock.DeleteOrganizationFunc(ctx, organizationID)
+}
+
+// DeleteProperty calls DeletePropertyFunc.
+func (mock *OrganizationService) DeleteProperty(ctx context.Context, organizationID int, propertyKey string) (*cloud.Response, error) {
+	if mock.DeletePropertyFunc == nil {
+		panic("mocks: OrganizationService.DeleteProperty is not implemented")
+	}
+	return mock.DeletePropertyFunc(ctx, organizationID, propertyKey)
+}
+
+// GetAllOrganizations calls GetAllOrganizationsFunc.
+func (mock *OrganizationService) GetAllOrganizations(ctx context.Context, start int, limit int, accountID string) (*cloud.PagedDTO, *cloud.Response, error) {
+	if mock.GetAllOrganizationsFunc == nil {
+		panic("mocks: OrganizationService.GetAllOrganizations is not implemented")
+	}
+	return mock.GetAllOrganizationsFunc(ctx, start, limit, accountID)
+}
+
+// GetOrganization calls GetOrganizationFunc.
+func (mock *OrganizationService) GetOrganization(ctx context.Context, organizationID int) (*cloud.Organization, *cloud.Response, error) {
+	if mock.GetOrganizationFunc == nil {
+		panic("mocks: OrganizationService.GetOrganization is not implemented")
+	}
+	return mock.GetOrganizationFunc(ctx, organizationID)
+}
+
+// GetPropertiesKeys calls GetPropertiesKeysFunc.
+func (mock *OrganizationService) GetPropertiesKeys(ctx context.Context, organizationID int) (*cloud.PropertyKeys, *cloud.Response, error) {
+	if mock.GetPropertiesKeysFunc == nil {
+		panic("mocks: OrganizationService.GetPropertiesKeys is not implemented")
+	}
+	return mock.GetPropertiesKeysFunc(ctx, organizationID)
+}
+
+// GetProperty calls GetPropertyFunc.
+func (mock *OrganizationService) GetProperty(ctx context.Context, organizationID int, propertyKey string) (*cloud.EntityProperty, *cloud.Response, error) {
+	if mock.GetPropertyFunc == nil {
+		panic("mocks: OrganizationService.GetProperty is not implemented")
+	}
+	return mock.GetPropertyFunc(ctx, organizationID, propertyKey)
+}
+
+// GetUsers calls GetUsersFunc.
+func (mock *OrganizationService) GetUsers(ctx context.Context, organizationID int, start int, limit int) (*cloud.PagedDTO, *cloud.Response, error) {
+	if mock.GetUsersFunc == nil {
+		panic("mocks: OrganizationService.GetUsers is not implemented")
+	}
+	return mock.GetUsersFunc(ctx, organizationID, start, limit)
+}
+
+// RemoveUsers calls RemoveUsersFunc.
+func (mock *OrganizationService) RemoveUsers(ctx context.Context, organizationID int, users cloud.OrganizationUsersDTO) (*cloud.Response, error) {
+	if mock.RemoveUsersFunc == nil {
+		panic("mocks: OrganizationService.RemoveUsers is not implemented")
+	}
+	return mock.RemoveUsersFunc(ctx, organizationID, users)
+}
+
+// SetProperty calls SetPropertyFunc.
+func (mock *OrganizationService) SetProperty(ctx context.Context, organizationID int, propertyKey string, value interface{}) (*cloud.Response, error) {
+	if mock.SetPropertyFunc == nil {
+		panic("mocks: OrganizationService.SetProperty is not implemented")
+	}
+	return mock.SetPropertyFunc(ctx, organizationID, propertyKey, value)
+}
+
+// PermissionSchemeService is a mock of cloud.PermissionSchemeAPI.
+type PermissionSchemeService struct {
+	GetFunc     func(context.Context, int) (*cloud.PermissionScheme, *cloud.Response, error)
+	GetListFunc func(context.Context) (*cloud.PermissionSchemes, *cloud.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *PermissionSchemeService) Get(ctx context.Context, schemeID int) (*cloud.PermissionScheme, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: PermissionSchemeService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, schemeID)
+}
+
+// GetList calls GetListFunc.
+func (mock *PermissionSchemeService) GetList(ctx context.Context) (*cloud.PermissionSchemes, *cloud.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: PermissionSchemeService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// PriorityService is a mock of cloud.PriorityAPI.
+type PriorityService struct {
+	GetListFunc func(context.Context) ([]cloud.Priority, *cloud.Response, error)
+}
+
+// GetList calls GetListFunc.
+func (mock *PriorityService) GetList(ctx context.Context) ([]cloud.Priority, *cloud.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: PriorityService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// ProjectCategoryService is a mock of cloud.ProjectCategoryAPI.
+type ProjectCategoryService struct {
+	CreateFunc  func(context.Context, string, string) (*cloud.ProjectCategory, *cloud.Response, error)
+	DeleteFunc  func(context.Context, string) (*cloud.Response, error)
+	GetFunc     func(context.Context, string) (*cloud.ProjectCategory, *cloud.Response, error)
+	GetListFunc func(context.Context) ([]cloud.ProjectCategory, *cloud.Response, error)
+	UpdateFunc  func(context.Context, string, string, string) (*cloud.ProjectCategory, *cloud.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *ProjectCategoryService) Create(ctx context.Context, name string, description string) (*cloud.ProjectCategory, *cloud.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: ProjectCategoryService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, name, description)
+}
+
+// Delete calls DeleteFunc.
+func (mock *ProjectCategoryService) Delete(ctx context.Context, categoryID string) (*cloud.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: ProjectCategoryService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, categoryID)
+}
+
+// Get calls GetFunc.
+func (mock *ProjectCategoryService) Get(ctx context.Context, categoryID string) (*cloud.ProjectCategory, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: ProjectCategoryService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, categoryID)
+}
+
+// GetList calls GetListFunc.
+func (mock *ProjectCategoryService) GetList(ctx context.Context) ([]cloud.ProjectCategory, *cloud.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: ProjectCategoryService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// Update calls UpdateFunc.
+func (mock *ProjectCategoryService) Update(ctx context.Context, categoryID string, name string, description string) (*cloud.ProjectCategory, *cloud.Response, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: ProjectCategoryService.Update is not implemented")
+	}
+	return mock.UpdateFunc(ctx, categoryID, name, description)
+}
+
+// ProjectService is a mock of cloud.ProjectAPI.
+type ProjectService struct {
+	ArchiveFunc                   func(context.Context, string) (*cloud.Response, error)
+	AssignIssueSecuritySchemeFunc func(context.Context, string, int) (*cloud.Project, *cloud.Response, error)
+	AssignNotificationSchemeFunc  func(context.Context, string, int) (*cloud.Project, *cloud.Response, error)
+	AssignPermissionSchemeFunc    func(context.Context, string, int) (*cloud.PermissionScheme, *cloud.Response, error)
+	CreateFunc                    func(context.Context, *cloud.ProjectDetails) (*cloud.ProjectIdentifiers, *cloud.Response, error)
+	DeleteFunc                    func(context.Context, string) (*cloud.Response, error)
+	FindFunc                      func(context.Context, ...cloud.UserSearchF) ([]cloud.Project, *cloud.Response, error)
+	GetFunc                       func(context.Context, string) (*cloud.Project, *cloud.Response, error)
+	GetAllFunc                    func(context.Context, *cloud.GetQueryOptions) (*cloud.ProjectList, *cloud.Response, error)
+	GetPermissionSchemeFunc       func(context.Context, string) (*cloud.PermissionScheme, *cloud.Response, error)
+	RestoreFunc                   func(context.Context, string) (*cloud.Response, error)
+	UpdateFunc                    func(context.Context, string, *cloud.ProjectDetails) (*cloud.Project, *cloud.Response, error)
+}
+
+// Archive calls ArchiveFunc.
+func (mock *ProjectService) Archive(ctx context.Context, projectIDOrKey string) (*cloud.Response, error) {
+	if mock.ArchiveFunc == nil {
+		panic("mocks: ProjectService.Archive is not implemented")
+	}
+	return mock.ArchiveFunc(ctx, projectIDOrKey)
+}
+
+// AssignIssueSecurityScheme calls AssignIssueSecuritySchemeFunc.
+func (mock *ProjectService) AssignIssueSecurityScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*cloud.Project, *cloud.Response, error) {
+	if mock.AssignIssueSecuritySchemeFunc == nil {
+		panic("mocks: ProjectService.AssignIssueSecurityScheme is not implemented")
+	}
+	return mock.AssignIssueSecuritySchemeFunc(ctx, projectIDOrKey, schemeID)
+}
+
+// AssignNotificationScheme calls AssignNotificationSchemeFunc.
+func (mock *ProjectService) AssignNotificationScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*cloud.Project, *cloud.Response, error) {
+	if mock.AssignNotificationSchemeFunc == nil {
+		panic("mocks: ProjectService.AssignNotificationScheme is not implemented")
+	}
+	return mock.AssignNotificationSchemeFunc(ctx, projectIDOrKey, schemeID)
+}
+
+// AssignPermissionScheme calls AssignPermissionSchemeFunc.
+func (mock *ProjectService) AssignPermissionScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*cloud.PermissionScheme, *cloud.Response, error) {
+	if mock.AssignPermissionSchemeFunc == nil {
+		panic("mocks: ProjectService.AssignPermissionScheme is not implemented")
+	}
+	return mock.AssignPermissionSchemeFunc(ctx, projectIDOrKey, schemeID)
+}
+
+// Create calls CreateFunc.
+func (mock *ProjectService) Create(ctx context.Context, project *cloud.ProjectDetails) (*cloud.ProjectIdentifiers, *cloud.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: ProjectService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, project)
+}
+
+// Delete calls DeleteFunc.
+func (mock *ProjectService) Delete(ctx context.Context, projectIDOrKey string) (*cloud.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: ProjectService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, projectIDOrKey)
+}
+
+// Find calls FindFunc.
+func (mock *ProjectService) Find(ctx context.Context, tweaks ...cloud.UserSearchF) ([]cloud.Project, *cloud.Response, error) {
+	if mock.FindFunc == nil {
+		panic("mocks: ProjectService.Find is not implemented")
+	}
+	return mock.FindFunc(ctx, tweaks...)
+}
+
+// Get calls GetFunc.
+func (mock *ProjectService) Get(ctx context.Context, projectID string) (*cloud.Project, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: ProjectService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, projectID)
+}
+
+// GetAll calls GetAllFunc.
+func (mock *ProjectService) GetAll(ctx context.Context, options *cloud.GetQueryOptions) (*cloud.ProjectList, *cloud.Response, error) {
+	if mock.GetAllFunc == nil {
+		panic("mocks: ProjectService.GetAll is not implemented")
+	}
+	return mock.GetAllFunc(ctx, options)
+}
+
+// GetPermissionScheme calls GetPermissionSchemeFunc.
+func (mock *ProjectService) GetPermissionScheme(ctx context.Context, projectID string) (*cloud.PermissionScheme, *cloud.Response, error) {
+	if mock.GetPermissionSchemeFunc == nil {
+		panic("mocks: ProjectService.GetPermissionScheme is not implemented")
+	}
+	return mock.GetPermissionSchemeFunc(ctx, projectID)
+}
+
+// Restore calls RestoreFunc.
+func (mock *ProjectService) Restore(ctx context.Context, projectIDOrKey string) (*cloud.Response, error) {
+	if mock.RestoreFunc == nil {
+		panic("mocks: ProjectService.Restore is not implemented")
+	}
+	return mock.RestoreFunc(ctx, projectIDOrKey)
+}
+
+// Update calls UpdateFunc.
+func (mock *ProjectService) Update(ctx context.Context, projectIDOrKey string, project *cloud.ProjectDetails) (*cloud.Project, *cloud.Response, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: ProjectService.Update is not implemented")
+	}
+	return mock.UpdateFunc(ctx, projectIDOrKey, project)
+}
+
+// PropertyService is a mock of cloud.PropertyAPI.
+type PropertyService struct {
+	DeleteFunc      func(context.Context, cloud.PropertyEntity, string, string) (*cloud.Response, error)
+	GetFunc         func(context.Context, cloud.PropertyEntity, string, string) (*cloud.Property, *cloud.Response, error)
+	GetKeysFunc     func(context.Context, cloud.PropertyEntity, string) ([]cloud.PropertyKey, *cloud.Response, error)
+	SetFunc         func(context.Context, cloud.PropertyEntity, string, string, interface{}) (*cloud.Response, error)
+	SetOnIssuesFunc func(context.Context, string, interface{}, *cloud.BulkPropertyFilter) (*cloud.Response, error)
+}
+
+// Delete calls DeleteFunc.
+func (mock *PropertyService) Delete(ctx context.Context, entity cloud.PropertyEntity, entityID string, key string) (*cloud.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: PropertyService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, entity, entityID, key)
+}
+
+// Get calls GetFunc.
+func (mock *PropertyService) Get(ctx context.Context, entity cloud.PropertyEntity, entityID string, key string) (*cloud.Property, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: PropertyService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, entity, entityID, key)
+}
+
+// GetKeys calls GetKeysFunc.
+func (mock *PropertyService) GetKeys(ctx context.Context, entity cloud.PropertyEntity, entityID string) ([]cloud.PropertyKey, *cloud.Response, error) {
+	if mock.GetKeysFunc == nil {
+		panic("mocks: PropertyService.GetKeys is not implemented")
+	}
+	return mock.GetKeysFunc(ctx, entity, entityID)
+}
+
+// Set calls SetFunc.
+func (mock *PropertyService) Set(ctx context.Context, entity cloud.PropertyEntity, entityID string, key string, value interface{}) (*cloud.Response, error) {
+	if mock.SetFunc == nil {
+		panic("mocks: PropertyService.Set is not implemented")
+	}
+	return mock.SetFunc(ctx, entity, entityID, key, value)
+}
+
+// SetOnIssues calls SetOnIssuesFunc.
+func (mock *PropertyService) SetOnIssues(ctx context.Context, key string, value interface{}, filter *cloud.BulkPropertyFilter) (*cloud.Response, error) {
+	if mock.SetOnIssuesFunc == nil {
+		panic("mocks: PropertyService.SetOnIssues is not implemented")
+	}
+	return mock.SetOnIssuesFunc(ctx, key, value, filter)
+}
+
+// RequestService is a mock of cloud.RequestAPI.
+type RequestService struct {
+	CreateFunc        func(context.Context, string, []string, *cloud.Request) (*cloud.Request, *cloud.Response, error)
+	CreateCommentFunc func(context.Context, string, *cloud.RequestComment) (*cloud.RequestComment, *cloud.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *RequestService) Create(ctx context.Context, requester string, participants []string, request *cloud.Request) (*cloud.Request, *cloud.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: RequestService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, requester, participants, request)
+}
+
+// CreateComment calls CreateCommentFunc.
+func (mock *RequestService) CreateComment(ctx context.Context, issueIDOrKey string, comment *cloud.RequestComment) (*cloud.RequestComment, *cloud.Response, error) {
+	if mock.CreateCommentFunc == nil {
+		panic("mocks: RequestService.CreateComment is not implemented")
+	}
+	return mock.CreateCommentFunc(ctx, issueIDOrKey, comment)
+}
+
+// ResolutionService is a mock of cloud.ResolutionAPI.
+type ResolutionService struct {
+	GetListFunc func(context.Context) ([]cloud.Resolution, *cloud.Response, error)
+}
+
+// GetList calls GetListFunc.
+func (mock *ResolutionService) GetList(ctx context.Context) ([]cloud.Resolution, *cloud.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: ResolutionService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// RoleService is a mock of cloud.RoleAPI.
+type RoleService struct {
+	AddGroupToRoleFunc          func(context.Context, string, int, string) ([]*cloud.Actor, *cloud.Response, error)
+	AddUserToRoleFunc           func(context.Context, string, int, string) (*cloud.Response, error)
+	GetFunc                     func(context.Context, int) (*cloud.Role, *cloud.Response, error)
+	GetListFunc                 func(context.Context) (*[]cloud.Role, *cloud.Response, error)
+	GetRoleActorsForProjectFunc func(context.Context, string, int) ([]*cloud.Actor, *cloud.Response, error)
+	RemoveGroupFromRoleFunc     func(context.Context, string, int, string) (*cloud.Response, error)
+	RemoveUserFromRoleFunc      func(context.Context, string, int, string) (*cloud.Response, error)
+}
+
+// AddGroupToRole calls AddGroupToRoleFunc.
+func (mock *RoleService) AddGroupToRole(ctx context.Context, projectID string, roleID int, groupID string) ([]*cloud.Actor, *cloud.Response, error) {
+	if mock.AddGroupToRoleFunc == nil {
+		panic("mocks: RoleService.AddGroupToRole is not implemented")
+	}
+	return mock.AddGroupToRoleFunc(ctx, projectID, roleID, groupID)
+}
+
+// AddUserToRole calls AddUserToRoleFunc.
+func (mock *RoleService) AddUserToRole(ctx context.Context, projectID string, roleID int, userID string) (*cloud.Response, error) {
+	if mock.AddUserToRoleFunc == nil {
+		panic("mocks: RoleService.AddUserToRole is not implemented")
+	}
+	return mock.AddUserToRoleFunc(ctx, projectID, roleID, userID)
+}
+
+// Get calls GetFunc.
+func (mock *RoleService) Get(ctx context.Context, roleID int) (*cloud.Role, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: RoleService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, roleID)
+}
+
+// GetList calls GetListFunc.
+func (mock *RoleService) GetList(ctx context.Context) (*[]cloud.Role, *cloud.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: RoleService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// GetRoleActorsForProject calls GetRoleActorsForProjectFunc.
+func (mock *RoleService) GetRoleActorsForProject(ctx context.Context, projectID string, roleID int) ([]*cloud.Actor, *cloud.Response, error) {
+	if mock.GetRoleActorsForProjectFunc == nil {
+		panic("mocks: RoleService.GetRoleActorsForProject is not implemented")
+	}
+	return mock.GetRoleActorsForProjectFunc(ctx, projectID, roleID)
+}
+
+// RemoveGroupFromRole calls RemoveGroupFromRoleFunc.
+func (mock *RoleService) RemoveGroupFromRole(ctx context.Context, projectID string, roleID int, groupID string) (*cloud.Response, error) {
+	if mock.RemoveGroupFromRoleFunc == nil {
+		panic("mocks: RoleService.RemoveGroupFromRole is not implemented")
+	}
+	return mock.RemoveGroupFromRoleFunc(ctx, projectID, roleID, groupID)
+}
+
+// RemoveUserFromRole calls RemoveUserFromRoleFunc.
+func (mock *RoleService) RemoveUserFromRole(ctx context.Context, projectID string, roleID int, userID string) (*cloud.Response, error) {
+	if mock.RemoveUserFromRoleFunc == nil {
+		panic("mocks: RoleService.RemoveUserFromRole is not implemented")
+	}
+	return mock.RemoveUserFromRoleFunc(ctx, projectID, roleID, userID)
+}
+
+// ServerInfoService is a mock of cloud.ServerInfoAPI.
+type ServerInfoService struct {
+	GetFunc func(context.Context) (*cloud.ServerInfo, *cloud.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *ServerInfoService) Get(ctx context.Context) (*cloud.ServerInfo, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: ServerInfoService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx)
+}
+
+// ServiceDeskService is a mock of cloud.ServiceDeskAPI.
+type ServiceDeskService struct {
+	AddCustomersFunc       func(context.Context, interface{}, ...string) (*cloud.Response, error)
+	AddOrganizationFunc    func(context.Context, interface{}, int) (*cloud.Response, error)
+	GetOrganizationsFunc   func(context.Context, interface{}, int, int, string) (*cloud.PagedDTO, *cloud.Response, error)
+	ListCustomersFunc      func(context.Context, interface{}, *cloud.CustomerListOptions) (*cloud.CustomerList, *cloud.Response, error)
+	RemoveCustomersFunc    func(context.Context, interface{}, ...string) (*cloud.Response, error)
+	RemoveOrganizationFunc func(context.Context, interface{}, int) (*cloud.Response, error)
+}
+
+// AddCustomers calls AddCustomersFunc.
+func (mock *ServiceDeskService) AddCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*cloud.Response, error) {
+	if mock.AddCustomersFunc == nil {
+		panic("mocks: ServiceDeskService.AddCustomers is not implemented")
+	}
+	return mock.AddCustomersFunc(ctx, serviceDeskID, acountIDs...)
+}
+
+// AddOrganization calls AddOrganizationFunc.
+func (mock *ServiceDeskService) AddOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*cloud.Response, error) {
+	if mock.AddOrganizationFunc == nil {
+		panic("mocks: ServiceDeskService.AddOrganization is not implemented")
+	}
+	return mock.AddOrganizationFunc(ctx, serviceDeskID, organizationID)
+}
+
+// GetOrganizations calls GetOrganizationsFunc.
+func (mock *ServiceDeskService) GetOrganizations(ctx context.Context, serviceDeskID interface{}, start int, limit int, accountID string) (*cloud.PagedDTO, *cloud.Response, error) {
+	if mock.GetOrganizationsFunc == nil {
+		panic("mocks: ServiceDeskService.GetOrganizations is not implemented")
+	}
+	return mock.GetOrganizationsFunc(ctx, serviceDeskID, start, limit, accountID)
+}
+
+// ListCustomers calls ListCustomersFunc.
+func (mock *ServiceDeskService) ListCustomers(ctx context.Context, serviceDeskID interface{}, options *cloud.CustomerListOptions) (*cloud.CustomerList, *cloud.Response, error) {
+	if mock.ListCustomersFunc == nil {
+		panic("mocks: ServiceDeskService.ListCustomers is not implemented")
+	}
+	return mock.ListCustomersFunc(ctx, serviceDeskID, options)
+}
+
+// RemoveCustomers calls RemoveCustomersFunc.
+func (mock *ServiceDeskService) RemoveCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*cloud.Response, error) {
+	if mock.RemoveCustomersFunc == nil {
+		panic("mocks: ServiceDeskService.RemoveCustomers is not implemented")
+	}
+	return mock.RemoveCustomersFunc(ctx, serviceDeskID, acountIDs...)
+}
+
+// RemoveOrganization calls RemoveOrganizationFunc.
+func (mock *ServiceDeskService) RemoveOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*cloud.Response, error) {
+	if mock.RemoveOrganizationFunc == nil {
+		panic("mocks: ServiceDeskService.RemoveOrganization is not implemented")
+	}
+	return mock.RemoveOrganizationFunc(ctx, serviceDeskID, organizationID)
+}
+
+// SprintService is a mock of cloud.SprintAPI.
+type SprintService struct {
+	GetIssueFunc           func(context.Context, string, *cloud.GetQueryOptions) (*cloud.Issue, *cloud.Response, error)
+	GetIssuesForSprintFunc func(context.Context, int) ([]cloud.Issue, *cloud.Response, error)
+	MoveIssuesToSprintFunc func(context.Context, int, []string) (*cloud.Response, error)
+}
+
+// GetIssue calls GetIssueFunc.
+func (mock *SprintService) GetIssue(ctx context.Context, issueID string, options *cloud.GetQueryOptions) (*cloud.Issue, *cloud.Response, error) {
+	if mock.GetIssueFunc == nil {
+		panic("mocks: SprintService.GetIssue is not implemented")
+	}
+	return mock.GetIssueFunc(ctx, issueID, options)
+}
+
+// GetIssuesForSprint calls GetIssuesForSprintFunc.
+func (mock *SprintService) GetIssuesForSprint(ctx context.Context, sprintID int) ([]cloud.Issue, *cloud.Response, error) {
+	if mock.GetIssuesForSprintFunc == nil {
+		panic("mocks: SprintService.GetIssuesForSprint is not implemented")
+	}
+	return mock.GetIssuesForSprintFunc(ctx, sprintID)
+}
+
+// MoveIssuesToSprint calls MoveIssuesToSprintFunc.
+func (mock *SprintService) MoveIssuesToSprint(ctx context.Context, sprintID int, issueIDs []string) (*cloud.Response, error) {
+	if mock.MoveIssuesToSprintFunc == nil {
+		panic("mocks: SprintService.MoveIssuesToSprint is not implemented")
+	}
+	return mock.MoveIssuesToSprintFunc(ctx, sprintID, issueIDs)
+}
+
+// StatusCategoryService is a mock of cloud.StatusCategoryAPI.
+type StatusCategoryService struct {
+	GetFunc     func(context.Context, string) (*cloud.StatusCategory, *cloud.Response, error)
+	GetListFunc func(context.Context) ([]cloud.StatusCategory, *cloud.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *StatusCategoryService) Get(ctx context.Context, statusCategoryID string) (*cloud.StatusCategory, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: StatusCategoryService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, statusCategoryID)
+}
+
+// GetList calls GetListFunc.
+func (mock *StatusCategoryService) GetList(ctx context.Context) ([]cloud.StatusCategory, *cloud.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: StatusCategoryService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// StatusService is a mock of cloud.StatusAPI.
+type StatusService struct {
+	GetAllStatusesFunc          func(context.Context) ([]cloud.Status, *cloud.Response, error)
+	SearchStatusesPaginatedFunc func(context.Context, ...cloud.UserSearchF) ([]cloud.JiraStatus, *cloud.Response, error)
+}
+
+// GetAllStatuses calls GetAllStatusesFunc.
+func (mock *StatusService) GetAllStatuses(ctx context.Context) ([]cloud.Status, *cloud.Response, error) {
+	if mock.GetAllStatusesFunc == nil {
+		panic("mocks: StatusService.GetAllStatuses is not implemented")
+	}
+	return mock.GetAllStatusesFunc(ctx)
+}
+
+// SearchStatusesPaginated calls SearchStatusesPaginatedFunc.
+func (mock *StatusService) SearchStatusesPaginated(ctx context.Context, tweaks ...cloud.UserSearchF) ([]cloud.JiraStatus, *cloud.Response, error) {
+	if mock.SearchStatusesPaginatedFunc == nil {
+		panic("mocks: StatusService.SearchStatusesPaginated is not implemented")
+	}
+	return mock.SearchStatusesPaginatedFunc(ctx, tweaks...)
+}
+
+// UserService is a mock of cloud.UserAPI.
+type UserService struct {
+	CreateFunc                        func(context.Context, *cloud.User) (*cloud.User, *cloud.Response, error)
+	DeleteFunc                        func(context.Context, string) (*cloud.Response, error)
+	FindFunc                          func(context.Context, string, ...cloud.UserSearchF) ([]cloud.User, *cloud.Response, error)
+	FindUsersWithBrowsePermissionFunc func(context.Context, string, ...cloud.UserSearchF) ([]cloud.User, *cloud.Response, error)
+	GetFunc                           func(context.Context, string) (*cloud.User, *cloud.Response, error)
+	GetAccountIDsFunc                 func(context.Context, []string, []string) ([]cloud.UserMigration, *cloud.Response, error)
+	GetByAccountIDFunc                func(context.Context, string) (*cloud.User, *cloud.Response, error)
+	GetCurrentUserFunc                func(context.Context) (*cloud.User, *cloud.Response, error)
+	GetGroupsFunc                     func(context.Context, string) (*[]cloud.UserGroup, *cloud.Response, error)
+	GetManyFunc                       func(context.Context, []string) ([]cloud.User, *cloud.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *UserService) Create(ctx context.Context, user *cloud.User) (*cloud.User, *cloud.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: UserService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, user)
+}
+
+// Delete calls DeleteFunc.
+func (mock *UserService) Delete(ctx context.Context, accountId string) (*cloud.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: UserService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, accountId)
+}
+
+// Find calls FindFunc.
+func (mock *UserService) Find(ctx context.Context, property string, tweaks ...cloud.UserSearchF) ([]cloud.User, *cloud.Response, error) {
+	if mock.FindFunc == nil {
+		panic("mocks: UserService.Find is not implemented")
+	}
+	return mock.FindFunc(ctx, property, tweaks...)
+}
+
+// FindUsersWithBrowsePermission calls FindUsersWithBrowsePermissionFunc.
+func (mock *UserService) FindUsersWithBrowsePermission(ctx context.Context, property string, tweaks ...cloud.UserSearchF) ([]cloud.User, *cloud.Response, error) {
+	if mock.FindUsersWithBrowsePermissionFunc == nil {
+		panic("mocks: UserService.FindUsersWithBrowsePermission is not implemented")
+	}
+	return mock.FindUsersWithBrowsePermissionFunc(ctx, property, tweaks...)
+}
+
+// Get calls GetFunc.
+func (mock *UserService) Get(ctx context.Context, accountId string) (*cloud.User, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: UserService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, accountId)
+}
+
+// GetAccountIDs calls GetAccountIDsFunc.
+func (mock *UserService) GetAccountIDs(ctx context.Context, usernames []string, keys []string) ([]cloud.UserMigration, *cloud.Response, error) {
+	if mock.GetAccountIDsFunc == nil {
+		panic("mocks: UserService.GetAccountIDs is not implemented")
+	}
+	return mock.GetAccountIDsFunc(ctx, usernames, keys)
+}
+
+// GetByAccountID calls GetByAccountIDFunc.
+func (mock *UserService) GetByAccountID(ctx context.Context, accountID string) (*cloud.User, *cloud.Response, error) {
+	if mock.GetByAccountIDFunc == nil {
+		panic("mocks: UserService.GetByAccountID is not implemented")
+	}
+	return mock.GetByAccountIDFunc(ctx, accountID)
+}
+
+// GetCurrentUser calls GetCurrentUserFunc.
+func (mock *UserService) GetCurrentUser(ctx context.Context) (*cloud.User, *cloud.Response, error) {
+	if mock.GetCurrentUserFunc == nil {
+		panic("mocks: UserService.GetCurrentUser is not implemented")
+	}
+	return mock.GetCurrentUserFunc(ctx)
+}
+
+// GetGroups calls GetGroupsFunc.
+func (mock *UserService) GetGroups(ctx context.Context, accountId string) (*[]cloud.UserGroup, *cloud.Response, error) {
+	if mock.GetGroupsFunc == nil {
+		panic("mocks: UserService.GetGroups is not implemented")
+	}
+	return mock.GetGroupsFunc(ctx, accountId)
+}
+
+// GetMany calls GetManyFunc.
+func (mock *UserService) GetMany(ctx context.Context, accountIDs []string) ([]cloud.User, *cloud.Response, error) {
+	if mock.GetManyFunc == nil {
+		panic("mocks: UserService.GetMany is not implemented")
+	}
+	return mock.GetManyFunc(ctx, accountIDs)
+}
+
+// VersionService is a mock of cloud.VersionAPI.
+type VersionService struct {
+	CreateFunc func(context.Context, *cloud.Version) (*cloud.Version, *cloud.Response, error)
+	GetFunc    func(context.Context, int) (*cloud.Version, *cloud.Response, error)
+	UpdateFunc func(context.Context, *cloud.Version) (*cloud.Version, *cloud.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *VersionService) Create(ctx context.Context, version *cloud.Version) (*cloud.Version, *cloud.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: VersionService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, version)
+}
+
+// Get calls GetFunc.
+func (mock *VersionService) Get(ctx context.Context, versionID int) (*cloud.Version, *cloud.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: VersionService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, versionID)
+}
+
+// Update calls UpdateFunc.
+func (mock *VersionService) Update(ctx context.Context, version *cloud.Version) (*cloud.Version, *cloud.Response, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: VersionService.Update is not implemented")
+	}
+	return mock.UpdateFunc(ctx, version)
+}
+
+// WebhookService is a mock of cloud.WebhookAPI.
+type WebhookService struct {
+	DeleteFunc    func(context.Context, ...int) (*cloud.Response, error)
+	GetAllFunc    func(context.Context, *cloud.WebhookListOptions) ([]cloud.Webhook, *cloud.Response, error)
+	GetFailedFunc func(context.Context, *cloud.FailedWebhookOptions) (*cloud.FailedWebhooks, *cloud.Response, error)
+	RefreshFunc   func(context.Context, ...int) (time.Time, *cloud.Response, error)
+	RegisterFunc  func(context.Context, string, []cloud.WebhookDetails) ([]cloud.WebhookRegistrationResult, *cloud.Response, error)
+}
+
+// Delete calls DeleteFunc.
+func (mock *WebhookService) Delete(ctx context.Context, ids ...int) (*cloud.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: WebhookService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, ids...)
+}
+
+// GetAll calls GetAllFunc.
+func (mock *WebhookService) GetAll(ctx context.Context, options *cloud.WebhookListOptions) ([]cloud.Webhook, *cloud.Response, error) {
+	if mock.GetAllFunc == nil {
+		panic("mocks: WebhookService.GetAll is not implemented")
+	}
+	return mock.GetAllFunc(ctx, options)
+}
+
+// GetFailed calls GetFailedFunc.
+func (mock *WebhookService) GetFailed(ctx context.Context, options *cloud.FailedWebhookOptions) (*cloud.FailedWebhooks, *cloud.Response, error) {
+	if mock.GetFailedFunc == nil {
+		panic("mocks: WebhookService.GetFailed is not implemented")
+	}
+	return mock.GetFailedFunc(ctx, options)
+}
+
+// Refresh calls RefreshFunc.
+func (mock *WebhookService) Refresh(ctx context.Context, ids ...int) (time.Time, *cloud.Response, error) {
+	if mock.RefreshFunc == nil {
+		panic("mocks: WebhookService.Refresh is not implemented")
+	}
+	return mock.RefreshFunc(ctx, ids...)
+}
+
+// Register calls RegisterFunc.
+func (mock *WebhookService) Register(ctx context.Context, url string, webhooks []cloud.WebhookDetails) ([]cloud.WebhookRegistrationResult, *cloud.Response, error) {
+	if mock.RegisterFunc == nil {
+		panic("mocks: WebhookService.Register is not implemented")
+	}
+	return mock.RegisterFunc(ctx, url, webhooks)
+}
+
+// Compile-time checks that the mocks implement the interfaces.
+var (
+	_ cloud.ApplicationRoleAPI  = (*ApplicationRoleService)(nil)
+	_ cloud.AuditAPI            = (*AuditService)(nil)
+	_ cloud.BoardAPI            = (*BoardService)(nil)
+	_ cloud.ComponentAPI        = (*ComponentService)(nil)
+	_ cloud.CustomerAPI         = (*CustomerService)(nil)
+	_ cloud.FieldAPI            = (*FieldService)(nil)
+	_ cloud.FilterAPI           = (*FilterService)(nil)
+	_ cloud.GroupAPI            = (*GroupService)(nil)
+	_ cloud.IssueLinkTypeAPI    = (*IssueLinkTypeService)(nil)
+	_ cloud.IssueAPI            = (*IssueService)(nil)
+	_ cloud.OrganizationAPI     = (*OrganizationService)(nil)
+	_ cloud.PermissionSchemeAPI = (*PermissionSchemeService)(nil)
+	_ cloud.PriorityAPI         = (*PriorityService)(nil)
+	_ cloud.ProjectCategoryAPI  = (*ProjectCategoryService)(nil)
+	_ cloud.ProjectAPI          = (*ProjectService)(nil)
+	_ cloud.PropertyAPI         = (*PropertyService)(nil)
+	_ cloud.RequestAPI          = (*RequestService)(nil)
+	_ cloud.ResolutionAPI       = (*ResolutionService)(nil)
+	_ cloud.RoleAPI             = (*RoleService)(nil)
+	_ cloud.ServerInfoAPI       = (*ServerInfoService)(nil)
+	_ cloud.ServiceDeskAPI      = (*ServiceDeskService)(nil)
+	_ cloud.SprintAPI           = (*SprintService)(nil)
+	_ cloud.StatusCategoryAPI   = (*StatusCategoryService)(nil)
+	_ cloud.StatusAPI           = (*StatusService)(nil)
+	_ cloud.UserAPI             = (*UserService)(nil)
+	_ cloud.VersionAPI          = (*VersionService)(nil)
+	_ cloud.WebhookAPI          = (*WebhookService)(nil)
+)
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -170,17 +172,35 @@ func TestOrganizationService_SetProperty(t *testing.T) {
 		testMethod(t, r, http.MethodPut)
 		testRequestURL(t, r, "/rest/servicedeskapi/organization/1/property/organization.attributes")
 
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("Error in read body: %s", err)
+		}
+		if got := strings.TrimSpace(string(body)); got != `{"phone":"0800-1234"}` {
+			t.Errorf("Expected body {\"phone\":\"0800-1234\"}, got %s", got)
+		}
+
 		w.WriteHeader(http.StatusOK)
 	})
 
 	key := "organization.attributes"
-	_, err := testClient.Organization.SetProperty(context.Background(), 1, key)
+	_, err := testClient.Organization.SetProperty(context.Background(), 1, key, map[string]string{"phone": "0800-1234"})
 
 	if err != nil {
 		t.Errorf("Error given: %s", err)
 	}
 }
 
+func TestOrganizationService_SetProperty_InvalidValue(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := testClient.Organization.SetProperty(context.Background(), 1, "organization.attributes", json.RawMessage(`{"phone":`))
+	if err == nil {
+		t.Error("Expected an error for a value which is not valid JSON")
+	}
+}
+
 func TestOrganizationService_DeleteProperty(t *testing.T) {
 	setup()
 	defer teardown()
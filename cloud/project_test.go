@@ -3,8 +3,10 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -214,3 +216,128 @@ func TestProjectService_Find_Success(t *testing.T) {
 		t.Errorf("Expected 10000. Projects[0].ID is %s", projects[0].ID)
 	}
 }
+
+func TestProjectService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		want := `{"key":"EX","name":"Example","leadAccountId":"5b10a2844c20165700ede21g","permissionScheme":10011,"projectTypeKey":"software","projectTemplateKey":"com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"}`
+		if got := strings.TrimSpace(string(body)); got != want {
+			t.Errorf("Request body: %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/project/10010","id":10010,"key":"EX"}`)
+	})
+
+	created, _, err := testClient.Project.Create(context.Background(), &ProjectDetails{
+		Key:                "EX",
+		Name:               "Example",
+		LeadAccountID:      "5b10a2844c20165700ede21g",
+		PermissionScheme:   10011,
+		ProjectTypeKey:     "software",
+		ProjectTemplateKey: "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if created.ID != 10010 || created.Key != "EX" {
+		t.Errorf("unexpected project %+v", created)
+	}
+}
+
+func TestProjectService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := strings.TrimSpace(string(body)), `{"name":"Renamed","categoryId":10000}`; got != want {
+			t.Errorf("Request body: %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"id":"10010","key":"EX","name":"Renamed","projectCategory":{"id":"10000","name":"FIRST"}}`)
+	})
+
+	project, _, err := testClient.Project.Update(context.Background(), "EX", &ProjectDetails{Name: "Renamed", CategoryID: 10000})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if project.Name != "Renamed" || project.ProjectCategory.ID != "10000" {
+		t.Errorf("unexpected project %+v", project)
+	}
+}
+
+func TestProjectService_Lifecycle(t *testing.T) {
+	setup()
+	defer teardown()
+	var calls []string
+	testMux.HandleFunc("/rest/api/3/project/EX/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		calls = append(calls, "archive")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/3/project/EX/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		calls = append(calls, "restore")
+		fmt.Fprint(w, `{"id":"10010","key":"EX"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/2/project/EX?enableUndo=true")
+		calls = append(calls, "delete")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := testClient.Project.Archive(ctx, "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Project.Restore(ctx, "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Project.Delete(ctx, "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if got, want := strings.Join(calls, ","), "archive,restore,delete"; got != want {
+		t.Errorf("calls = %s, want %s", got, want)
+	}
+}
+
+func TestProjectService_AssignSchemes(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX/permissionscheme", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := strings.TrimSpace(string(body)), `{"id":10011}`; got != want {
+			t.Errorf("Request body: %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"id":10011,"name":"Default Permission Scheme"}`)
+	})
+	var bodies []string
+	testMux.HandleFunc("/rest/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, strings.TrimSpace(string(body)))
+		fmt.Fprint(w, `{"id":"10010","key":"EX"}`)
+	})
+
+	ctx := context.Background()
+	scheme, _, err := testClient.Project.AssignPermissionScheme(ctx, "EX", 10011)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if scheme.ID != 10011 {
+		t.Errorf("unexpected scheme %+v", scheme)
+	}
+	if _, _, err := testClient.Project.AssignNotificationScheme(ctx, "EX", 10020); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, _, err := testClient.Project.AssignIssueSecurityScheme(ctx, "EX", 10030); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if got, want := strings.Join(bodies, ","), `{"notificationScheme":10020},{"issueSecurityScheme":10030}`; got != want {
+		t.Errorf("Request bodies: %s, want %s", got, want)
+	}
+}
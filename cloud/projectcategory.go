@@ -0,0 +1,93 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// ProjectCategoryService handles project categories for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-categories/
+type ProjectCategoryService service
+
+// projectCategoryInput is the request body to create or update a project category.
+type projectCategoryInput struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// GetList returns all project categories.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-categories/#api-rest-api-2-projectcategory-get
+func (s *ProjectCategoryService) GetList(ctx context.Context) ([]ProjectCategory, *Response, error) {
+	apiEndpoint := "rest/api/2/projectCategory"
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var categories []ProjectCategory
+	resp, err := s.client.Do(req, &categories)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return categories, resp, nil
+}
+
+// Get returns the project category with the given ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-categories/#api-rest-api-2-projectcategory-id-get
+func (s *ProjectCategoryService) Get(ctx context.Context, categoryID string) (*ProjectCategory, *Response, error) {
+	apiEndpoint := "rest/api/2/projectCategory/" + url.PathEscape(categoryID)
+	return s.do(ctx, http.MethodGet, apiEndpoint, nil)
+}
+
+// Create creates a project category with the given name and description.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-categories/#api-rest-api-2-projectcategory-post
+func (s *ProjectCategoryService) Create(ctx context.Context, name, description string) (*ProjectCategory, *Response, error) {
+	apiEndpoint := "rest/api/2/projectCategory"
+	return s.do(ctx, http.MethodPost, apiEndpoint, &projectCategoryInput{Name: name, Description: description})
+}
+
+// Update changes the name and description of the project category with the given ID.
+// Empty values are not changed.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-categories/#api-rest-api-2-projectcategory-id-put
+func (s *ProjectCategoryService) Update(ctx context.Context, categoryID, name, description string) (*ProjectCategory, *Response, error) {
+	apiEndpoint := "rest/api/2/projectCategory/" + url.PathEscape(categoryID)
+	return s.do(ctx, http.MethodPut, apiEndpoint, &projectCategoryInput{Name: name, Description: description})
+}
+
+// Delete deletes the project category with the given ID. Its projects keep existing without a category.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-categories/#api-rest-api-2-projectcategory-id-delete
+func (s *ProjectCategoryService) Delete(ctx context.Context, categoryID string) (*Response, error) {
+	apiEndpoint := "rest/api/2/projectCategory/" + url.PathEscape(categoryID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// do sends a request returning a project category.
+func (s *ProjectCategoryService) do(ctx context.Context, method, apiEndpoint string, body interface{}) (*ProjectCategory, *Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	category := new(ProjectCategory)
+	resp, err := s.client.Do(req, category)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return category, resp, nil
+}
@@ -0,0 +1,74 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestProjectCategoryService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/projectCategory", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"self":"https://your-domain.atlassian.net/rest/api/2/projectCategory/10000","id":"10000","name":"FIRST","description":"First Project Category"}]`)
+	})
+
+	categories, _, err := testClient.ProjectCategory.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(categories) != 1 || categories[0].ID != "10000" || categories[0].Name != "FIRST" {
+		t.Errorf("unexpected categories %+v", categories)
+	}
+}
+
+func TestProjectCategoryService_CreateUpdateDelete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/projectCategory", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if got, want := strings.TrimSpace(string(body)), `{"name":"CREATED","description":"Created Project Category"}`; got != want {
+			t.Errorf("Request body: %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10100","name":"CREATED","description":"Created Project Category"}`)
+	})
+	testMux.HandleFunc("/rest/api/2/projectCategory/10100", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if got, want := strings.TrimSpace(string(body)), `{"name":"UPDATED"}`; got != want {
+				t.Errorf("Request body: %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"id":"10100","name":"UPDATED","description":"Created Project Category"}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	category, _, err := testClient.ProjectCategory.Create(ctx, "CREATED", "Created Project Category")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if category.ID != "10100" {
+		t.Errorf("unexpected category %+v", category)
+	}
+	category, _, err = testClient.ProjectCategory.Update(ctx, category.ID, "UPDATED", "")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if category.Name != "UPDATED" {
+		t.Errorf("unexpected category %+v", category)
+	}
+	if _, err := testClient.ProjectCategory.Delete(ctx, category.ID); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,67 @@
+package projectconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Status is the outcome of applying a change.
+type Status string
+
+// Statuses of applied changes.
+const (
+	StatusApplied Status = "applied"
+	StatusFailed  Status = "failed"
+	// StatusSkipped is a change which was not applied, because a change of a kind it depends on failed.
+	StatusSkipped Status = "skipped"
+)
+
+// Result is the outcome of applying a change.
+type Result struct {
+	Change *Change
+	Status Status
+	Err    error
+}
+
+// Apply applies the changes of plan in dependency order: issue link types, the permission scheme,
+// role actors, components and versions.
+// Changes of the same kind are all attempted, but if one of them fails,
+// the changes of the following kinds are skipped.
+//
+// It returns one result per change, in the order of the plan,
+// and an error joining the errors of all failed changes.
+func (e *Engine) Apply(ctx context.Context, plan *Plan) ([]Result, error) {
+	results := make([]Result, len(plan.Changes))
+	for i := range plan.Changes {
+		results[i] = Result{Change: &plan.Changes[i], Status: StatusSkipped}
+	}
+
+	var errs []error
+	for _, kind := range applyOrder {
+		if len(errs) > 0 {
+			break
+		}
+		for i := range results {
+			change := results[i].Change
+			if change.Kind != kind {
+				continue
+			}
+			if change.apply == nil {
+				// Changes decoded from JSON can't be applied, only plans made by the Engine can
+				results[i].Status = StatusFailed
+				results[i].Err = fmt.Errorf("projectconfig: %s can't be applied", change)
+				errs = append(errs, results[i].Err)
+				continue
+			}
+			if err := change.apply(ctx, e.client); err != nil {
+				results[i].Status = StatusFailed
+				results[i].Err = fmt.Errorf("projectconfig: %s: %w", change, err)
+				errs = append(errs, results[i].Err)
+				continue
+			}
+			results[i].Status = StatusApplied
+		}
+	}
+	return results, errors.Join(errs...)
+}
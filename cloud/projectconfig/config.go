@@ -0,0 +1,177 @@
+// Package projectconfig manages the configuration of a Jira Cloud project as code.
+//
+// A Config describes the desired state of a project in YAML or JSON: its components, versions,
+// role actors and permission scheme, and the issue link types of the instance.
+// An Engine compares it to the live project and returns a Plan of the changes,
+// which can be reviewed, for example in a pull request, and applied in dependency order.
+//
+// Only the resources and fields in the Config are managed. Components, versions and
+// issue link types which are not in the Config are left alone, as are fields which are not set.
+// The actors of the roles in the Config are authoritative: actors which are not listed are removed.
+package projectconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/conductorone/go-jira/v2/cloud"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the desired state of a project.
+type Config struct {
+	// Project is the key of the project, like "EX". Required.
+	Project string `json:"project" yaml:"project"`
+
+	// PermissionScheme is the name or ID of the permission scheme of the project.
+	PermissionScheme string `json:"permissionScheme,omitempty" yaml:"permissionScheme,omitempty"`
+
+	// Components, Versions and IssueLinkTypes are created or updated, never deleted.
+	Components     []Component     `json:"components,omitempty" yaml:"components,omitempty"`
+	Versions       []Version       `json:"versions,omitempty" yaml:"versions,omitempty"`
+	IssueLinkTypes []IssueLinkType `json:"issueLinkTypes,omitempty" yaml:"issueLinkTypes,omitempty"`
+
+	// Roles lists the actors of roles. Actors of these roles which are not listed are removed.
+	Roles []RoleActors `json:"roles,omitempty" yaml:"roles,omitempty"`
+}
+
+// Component is a component of the project, identified by its name.
+type Component struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Lead is the account ID of the component lead.
+	Lead string `json:"lead,omitempty" yaml:"lead,omitempty"`
+	// AssigneeType is one of the cloud.AssigneeType constants, like "COMPONENT_LEAD".
+	AssigneeType string `json:"assigneeType,omitempty" yaml:"assigneeType,omitempty"`
+}
+
+// Version is a version of the project, identified by its name.
+type Version struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Released    *bool  `json:"released,omitempty" yaml:"released,omitempty"`
+	Archived    *bool  `json:"archived,omitempty" yaml:"archived,omitempty"`
+	// StartDate and ReleaseDate are dates like "2024-01-31".
+	StartDate   string `json:"startDate,omitempty" yaml:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty" yaml:"releaseDate,omitempty"`
+}
+
+// RoleActors are the actors of a project role.
+type RoleActors struct {
+	// Role is the name of the role, like "Developers".
+	Role string `json:"role" yaml:"role"`
+	// Users are the account IDs of the users in the role.
+	Users []string `json:"users,omitempty" yaml:"users,omitempty"`
+	// Groups are the names of the groups in the role.
+	Groups []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+}
+
+// IssueLinkType is an issue link type of the instance, identified by its name.
+type IssueLinkType struct {
+	Name    string `json:"name" yaml:"name"`
+	Inward  string `json:"inward" yaml:"inward"`
+	Outward string `json:"outward" yaml:"outward"`
+}
+
+// Parse parses a Config from YAML or JSON and validates it.
+// Unknown fields are rejected, so typos don't go unnoticed.
+func Parse(data []byte) (*Config, error) {
+	return Decode(bytes.NewReader(data))
+}
+
+// Decode reads a Config in YAML or JSON from r and validates it.
+func Decode(r io.Reader) (*Config, error) {
+	// JSON is a subset of YAML, so a YAML decoder reads both
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	cfg := new(Config)
+	if err := decoder.Decode(cfg); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("projectconfig: empty config")
+		}
+		return nil, fmt.Errorf("projectconfig: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Load reads a Config in YAML or JSON from the file at path and validates it.
+func Load(path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	cfg, err := Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%s)", err, path)
+	}
+	return cfg, nil
+}
+
+// Validate checks that the required fields are set and that names are unique.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Project == "" {
+		errs = append(errs, errors.New("project is required"))
+	}
+
+	components := make(map[string]bool, len(c.Components))
+	for i, component := range c.Components {
+		switch {
+		case component.Name == "":
+			errs = append(errs, fmt.Errorf("components[%d]: name is required", i))
+		case components[component.Name]:
+			errs = append(errs, fmt.Errorf("components[%d]: duplicate component %q", i, component.Name))
+		}
+		components[component.Name] = true
+		switch component.AssigneeType {
+		case "", cloud.AssigneeTypeProjectLead, cloud.AssigneeTypeComponentLead, cloud.AssigneeTypeUnassigned, cloud.AssigneeTypeProjectDefault:
+		default:
+			errs = append(errs, fmt.Errorf("components[%d]: invalid assignee type %q", i, component.AssigneeType))
+		}
+	}
+
+	versions := make(map[string]bool, len(c.Versions))
+	for i, version := range c.Versions {
+		switch {
+		case version.Name == "":
+			errs = append(errs, fmt.Errorf("versions[%d]: name is required", i))
+		case versions[version.Name]:
+			errs = append(errs, fmt.Errorf("versions[%d]: duplicate version %q", i, version.Name))
+		}
+		versions[version.Name] = true
+	}
+
+	roles := make(map[string]bool, len(c.Roles))
+	for i, role := range c.Roles {
+		switch {
+		case role.Role == "":
+			errs = append(errs, fmt.Errorf("roles[%d]: role is required", i))
+		case roles[role.Role]:
+			errs = append(errs, fmt.Errorf("roles[%d]: duplicate role %q", i, role.Role))
+		}
+		roles[role.Role] = true
+	}
+
+	linkTypes := make(map[string]bool, len(c.IssueLinkTypes))
+	for i, linkType := range c.IssueLinkTypes {
+		switch {
+		case linkType.Name == "" || linkType.Inward == "" || linkType.Outward == "":
+			errs = append(errs, fmt.Errorf("issueLinkTypes[%d]: name, inward and outward are required", i))
+		case linkTypes[linkType.Name]:
+			errs = append(errs, fmt.Errorf("issueLinkTypes[%d]: duplicate issue link type %q", i, linkType.Name))
+		}
+		linkTypes[linkType.Name] = true
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("projectconfig: invalid config: %w", errors.Join(errs...))
+	}
+	return nil
+}
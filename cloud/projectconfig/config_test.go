@@ -0,0 +1,95 @@
+package projectconfig
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testConfigYAML = `
+project: EX
+permissionScheme: Strict
+issueLinkTypes:
+  - name: Blocks
+    inward: is blocked by
+    outward: blocks
+components:
+  - name: Backend
+    description: API and workers
+    lead: a1
+    assigneeType: COMPONENT_LEAD
+versions:
+  - name: "1.0"
+    released: true
+    releaseDate: "2024-01-31"
+roles:
+  - role: Developers
+    users: [a1, a2]
+    groups: [devs]
+`
+
+func TestParse_YAML(t *testing.T) {
+	cfg, err := Parse([]byte(testConfigYAML))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if cfg.Project != "EX" || cfg.PermissionScheme != "Strict" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+	if len(cfg.Components) != 1 || cfg.Components[0].AssigneeType != "COMPONENT_LEAD" {
+		t.Errorf("unexpected components %+v", cfg.Components)
+	}
+	if len(cfg.Versions) != 1 || cfg.Versions[0].Released == nil || !*cfg.Versions[0].Released {
+		t.Errorf("unexpected versions %+v", cfg.Versions)
+	}
+	if len(cfg.Roles) != 1 || len(cfg.Roles[0].Users) != 2 || cfg.Roles[0].Groups[0] != "devs" {
+		t.Errorf("unexpected roles %+v", cfg.Roles)
+	}
+}
+
+func TestParse_JSON(t *testing.T) {
+	cfg, err := Parse([]byte(`{"project":"EX","components":[{"name":"Backend"}],"issueLinkTypes":[{"name":"Blocks","inward":"is blocked by","outward":"blocks"}]}`))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if cfg.Project != "EX" || len(cfg.Components) != 1 || cfg.IssueLinkTypes[0].Outward != "blocks" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := map[string]struct {
+		config string
+		want   string
+	}{
+		"unknown field":       {"project: EX\ncomponent: []\n", "field component not found"},
+		"missing project":     {"components: [{name: Backend}]\n", "project is required"},
+		"duplicate":           {"project: EX\nversions: [{name: '1.0'}, {name: '1.0'}]\n", `duplicate version "1.0"`},
+		"invalid assignee":    {"project: EX\ncomponents: [{name: Backend, assigneeType: NOBODY}]\n", `invalid assignee type "NOBODY"`},
+		"incomplete linktype": {"project: EX\nissueLinkTypes: [{name: Blocks}]\n", "name, inward and outward are required"},
+		"empty":               {"", "empty config"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(test.config))
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("expected error containing %q, got %v", test.want, err)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "project.yaml")
+	if err := os.WriteFile(path, []byte(testConfigYAML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if cfg.Project != "EX" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+}
@@ -0,0 +1,488 @@
+package projectconfig
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/conductorone/go-jira/v2/cloud"
+)
+
+// Kind is a kind of managed resource.
+type Kind string
+
+// Kinds of managed resources, in the order in which their changes are applied:
+// issue link types and the permission scheme don't depend on anything, role actors need
+// the permissions of the scheme, and component leads are usually members of a role.
+const (
+	KindIssueLinkType    Kind = "issueLinkType"
+	KindPermissionScheme Kind = "permissionScheme"
+	KindRoleActor        Kind = "roleActor"
+	KindComponent        Kind = "component"
+	KindVersion          Kind = "version"
+)
+
+// applyOrder is the order in which the changes of the kinds are applied.
+var applyOrder = []Kind{KindIssueLinkType, KindPermissionScheme, KindRoleActor, KindComponent, KindVersion}
+
+// Action is the change of a resource.
+type Action string
+
+// Actions of changes.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// symbol returns the prefix of the action in Plan.String.
+func (a Action) symbol() string {
+	switch a {
+	case ActionCreate:
+		return "+"
+	case ActionDelete:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+// Diff is a changed field of a resource.
+type Diff struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// Change is a planned change of a resource.
+type Change struct {
+	Kind   Kind   `json:"kind"`
+	Action Action `json:"action"`
+	// Name identifies the resource, like the name of a component or "Developers: user 5b10ac8d82e05b22cc7d4ef5".
+	Name  string `json:"name"`
+	Diffs []Diff `json:"diffs,omitempty"`
+
+	apply func(ctx context.Context, client *cloud.Client) error
+}
+
+// String returns the change in one line, like `+ component "Backend"`.
+func (c *Change) String() string {
+	return fmt.Sprintf("%s %s %q", c.Action.symbol(), c.Kind, c.Name)
+}
+
+// Plan is the list of changes to bring a project to the state of a Config.
+type Plan struct {
+	Project string   `json:"project"`
+	Changes []Change `json:"changes"`
+}
+
+// Empty reports whether the project is in the desired state.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns the plan for review, with one line per change and one line per changed field.
+func (p *Plan) String() string {
+	counts := make(map[Action]int)
+	for _, c := range p.Changes {
+		counts[c.Action]++
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Plan for project %s: %d to create, %d to update, %d to delete\n",
+		p.Project, counts[ActionCreate], counts[ActionUpdate], counts[ActionDelete])
+	for i := range p.Changes {
+		c := &p.Changes[i]
+		fmt.Fprintf(&b, "  %s\n", c)
+		for _, d := range c.Diffs {
+			fmt.Fprintf(&b, "      %s: %q -> %q\n", d.Field, d.From, d.To)
+		}
+	}
+	return b.String()
+}
+
+// Engine plans and applies Configs with a Jira Cloud client.
+type Engine struct {
+	client *cloud.Client
+}
+
+// New returns an Engine using client, which needs the Administer Jira global permission
+// to manage issue link types and permission schemes.
+func New(client *cloud.Client) *Engine {
+	return &Engine{client: client}
+}
+
+// Plan compares cfg to the live project and returns the changes in the order they are applied.
+// It only reads from Jira.
+func (e *Engine) Plan(ctx context.Context, cfg *Config) (*Plan, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	project, _, err := e.client.Project.Get(ctx, cfg.Project)
+	if err != nil {
+		return nil, fmt.Errorf("projectconfig: getting project %s: %w", cfg.Project, err)
+	}
+
+	plan := &Plan{Project: project.Key}
+	planners := []func(context.Context, *Config, *cloud.Project) ([]Change, error){
+		e.planIssueLinkTypes,
+		e.planPermissionScheme,
+		e.planRoleActors,
+		e.planComponents,
+		e.planVersions,
+	}
+	for _, planner := range planners {
+		changes, err := planner(ctx, cfg, project)
+		if err != nil {
+			return nil, fmt.Errorf("projectconfig: %w", err)
+		}
+		plan.Changes = append(plan.Changes, changes...)
+	}
+	return plan, nil
+}
+
+func (e *Engine) planIssueLinkTypes(ctx context.Context, cfg *Config, _ *cloud.Project) ([]Change, error) {
+	if len(cfg.IssueLinkTypes) == 0 {
+		return nil, nil
+	}
+	live, _, err := e.client.IssueLinkType.GetList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting issue link types: %w", err)
+	}
+	byName := make(map[string]cloud.IssueLinkType, len(live))
+	for _, linkType := range live {
+		byName[linkType.Name] = linkType
+	}
+
+	var changes []Change
+	for _, want := range cfg.IssueLinkTypes {
+		want := want
+		have, ok := byName[want.Name]
+		if !ok {
+			changes = append(changes, Change{
+				Kind:   KindIssueLinkType,
+				Action: ActionCreate,
+				Name:   want.Name,
+				apply: func(ctx context.Context, client *cloud.Client) error {
+					_, _, err := client.IssueLinkType.Create(ctx, &cloud.IssueLinkType{Name: want.Name, Inward: want.Inward, Outward: want.Outward})
+					return err
+				},
+			})
+			continue
+		}
+		var diffs []Diff
+		diffs = appendDiff(diffs, "inward", have.Inward, want.Inward)
+		diffs = appendDiff(diffs, "outward", have.Outward, want.Outward)
+		if len(diffs) == 0 {
+			continue
+		}
+		id := have.ID
+		changes = append(changes, Change{
+			Kind:   KindIssueLinkType,
+			Action: ActionUpdate,
+			Name:   want.Name,
+			Diffs:  diffs,
+			apply: func(ctx context.Context, client *cloud.Client) error {
+				_, _, err := client.IssueLinkType.Update(ctx, &cloud.IssueLinkType{ID: id, Name: want.Name, Inward: want.Inward, Outward: want.Outward})
+				return err
+			},
+		})
+	}
+	return changes, nil
+}
+
+func (e *Engine) planPermissionScheme(ctx context.Context, cfg *Config, project *cloud.Project) ([]Change, error) {
+	if cfg.PermissionScheme == "" {
+		return nil, nil
+	}
+	schemes, _, err := e.client.PermissionScheme.GetList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting permission schemes: %w", err)
+	}
+	var want *cloud.PermissionScheme
+	for i, scheme := range schemes.PermissionSchemes {
+		if scheme.Name == cfg.PermissionScheme || strconv.Itoa(scheme.ID) == cfg.PermissionScheme {
+			want = &schemes.PermissionSchemes[i]
+			break
+		}
+	}
+	if want == nil {
+		return nil, fmt.Errorf("permission scheme %q not found", cfg.PermissionScheme)
+	}
+
+	have, _, err := e.client.Project.GetPermissionScheme(ctx, project.Key)
+	if err != nil {
+		return nil, fmt.Errorf("getting the permission scheme of project %s: %w", project.Key, err)
+	}
+	if have.ID == want.ID {
+		return nil, nil
+	}
+	key, id := project.Key, want.ID
+	return []Change{{
+		Kind:   KindPermissionScheme,
+		Action: ActionUpdate,
+		Name:   want.Name,
+		Diffs:  []Diff{{Field: "permissionScheme", From: have.Name, To: want.Name}},
+		apply: func(ctx context.Context, client *cloud.Client) error {
+			_, _, err := client.Project.AssignPermissionScheme(ctx, key, id)
+			return err
+		},
+	}}, nil
+}
+
+// planRoleActors plans the addition of the listed actors and the removal of the actors which are not listed.
+func (e *Engine) planRoleActors(ctx context.Context, cfg *Config, project *cloud.Project) ([]Change, error) {
+	if len(cfg.Roles) == 0 {
+		return nil, nil
+	}
+	roles, _, err := e.client.Role.GetList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting roles: %w", err)
+	}
+	roleIDs := make(map[string]int, len(*roles))
+	for _, role := range *roles {
+		roleIDs[role.Name] = role.ID
+	}
+
+	var changes []Change
+	for _, want := range cfg.Roles {
+		roleID, ok := roleIDs[want.Role]
+		if !ok {
+			return nil, fmt.Errorf("role %q not found", want.Role)
+		}
+		actors, _, err := e.client.Role.GetRoleActorsForProject(ctx, project.Key, roleID)
+		if err != nil {
+			return nil, fmt.Errorf("getting the actors of role %q: %w", want.Role, err)
+		}
+		users := make(map[string]bool)
+		groups := make(map[string]bool)
+		for _, actor := range actors {
+			switch {
+			case actor.ActorUser != nil && actor.ActorUser.AccountID != "":
+				users[actor.ActorUser.AccountID] = true
+			case actor.ActorGroup != nil && actor.ActorGroup.Name != "":
+				groups[actor.ActorGroup.Name] = true
+			}
+		}
+
+		key, role := project.Key, want.Role
+		for _, user := range want.Users {
+			if users[user] {
+				delete(users, user)
+				continue
+			}
+			user := user
+			changes = append(changes, Change{
+				Kind:   KindRoleActor,
+				Action: ActionCreate,
+				Name:   role + ": user " + user,
+				apply: func(ctx context.Context, client *cloud.Client) error {
+					_, err := client.Role.AddUserToRole(ctx, key, roleID, user)
+					return err
+				},
+			})
+		}
+		for _, group := range want.Groups {
+			if groups[group] {
+				delete(groups, group)
+				continue
+			}
+			group := group
+			changes = append(changes, Change{
+				Kind:   KindRoleActor,
+				Action: ActionCreate,
+				Name:   role + ": group " + group,
+				apply: func(ctx context.Context, client *cloud.Client) error {
+					groupID, err := findGroupID(ctx, client, group)
+					if err != nil {
+						return err
+					}
+					_, _, err = client.Role.AddGroupToRole(ctx, key, roleID, groupID)
+					return err
+				},
+			})
+		}
+		for _, user := range sortedKeys(users) {
+			user := user
+			changes = append(changes, Change{
+				Kind:   KindRoleActor,
+				Action: ActionDelete,
+				Name:   role + ": user " + user,
+				apply: func(ctx context.Context, client *cloud.Client) error {
+					_, err := client.Role.RemoveUserFromRole(ctx, key, roleID, user)
+					return err
+				},
+			})
+		}
+		for _, group := range sortedKeys(groups) {
+			group := group
+			changes = append(changes, Change{
+				Kind:   KindRoleActor,
+				Action: ActionDelete,
+				Name:   role + ": group " + group,
+				apply: func(ctx context.Context, client *cloud.Client) error {
+					// Role actors are removed by group name
+					_, err := client.Role.RemoveGroupFromRole(ctx, key, roleID, group)
+					return err
+				},
+			})
+		}
+	}
+	return changes, nil
+}
+
+// planComponents plans the creation and update of components. Components which are not in the Config are left alone.
+func (e *Engine) planComponents(_ context.Context, cfg *Config, project *cloud.Project) ([]Change, error) {
+	byName := make(map[string]cloud.ProjectComponent, len(project.Components))
+	for _, component := range project.Components {
+		byName[component.Name] = component
+	}
+
+	var changes []Change
+	for _, want := range cfg.Components {
+		want := want
+		options := &cloud.ComponentCreateOptions{
+			Name:          want.Name,
+			Description:   want.Description,
+			LeadAccountId: want.Lead,
+			AssigneeType:  want.AssigneeType,
+		}
+		have, ok := byName[want.Name]
+		if !ok {
+			options.Project = project.Key
+			changes = append(changes, Change{
+				Kind:   KindComponent,
+				Action: ActionCreate,
+				Name:   want.Name,
+				apply: func(ctx context.Context, client *cloud.Client) error {
+					_, _, err := client.Component.Create(ctx, options)
+					return err
+				},
+			})
+			continue
+		}
+		var diffs []Diff
+		diffs = appendDiff(diffs, "description", have.Description, want.Description)
+		diffs = appendDiff(diffs, "lead", have.Lead.AccountID, want.Lead)
+		diffs = appendDiff(diffs, "assigneeType", have.AssigneeType, want.AssigneeType)
+		if len(diffs) == 0 {
+			continue
+		}
+		id := have.ID
+		changes = append(changes, Change{
+			Kind:   KindComponent,
+			Action: ActionUpdate,
+			Name:   want.Name,
+			Diffs:  diffs,
+			apply: func(ctx context.Context, client *cloud.Client) error {
+				_, _, err := client.Component.Update(ctx, id, options)
+				return err
+			},
+		})
+	}
+	return changes, nil
+}
+
+// planVersions plans the creation and update of versions. Versions which are not in the Config are left alone.
+func (e *Engine) planVersions(_ context.Context, cfg *Config, project *cloud.Project) ([]Change, error) {
+	if len(cfg.Versions) == 0 {
+		return nil, nil
+	}
+	projectID, err := strconv.Atoi(project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID %q of project %s", project.ID, project.Key)
+	}
+	byName := make(map[string]cloud.Version, len(project.Versions))
+	for _, version := range project.Versions {
+		byName[version.Name] = version
+	}
+
+	var changes []Change
+	for _, want := range cfg.Versions {
+		version := &cloud.Version{
+			Name:        want.Name,
+			Description: want.Description,
+			Released:    want.Released,
+			Archived:    want.Archived,
+			StartDate:   want.StartDate,
+			ReleaseDate: want.ReleaseDate,
+		}
+		have, ok := byName[want.Name]
+		if !ok {
+			version.ProjectID = projectID
+			changes = append(changes, Change{
+				Kind:   KindVersion,
+				Action: ActionCreate,
+				Name:   want.Name,
+				apply: func(ctx context.Context, client *cloud.Client) error {
+					_, _, err := client.Version.Create(ctx, version)
+					return err
+				},
+			})
+			continue
+		}
+		var diffs []Diff
+		diffs = appendDiff(diffs, "description", have.Description, want.Description)
+		diffs = appendBoolDiff(diffs, "released", have.Released, want.Released)
+		diffs = appendBoolDiff(diffs, "archived", have.Archived, want.Archived)
+		diffs = appendDiff(diffs, "startDate", have.StartDate, want.StartDate)
+		diffs = appendDiff(diffs, "releaseDate", have.ReleaseDate, want.ReleaseDate)
+		if len(diffs) == 0 {
+			continue
+		}
+		version.ID = have.ID
+		changes = append(changes, Change{
+			Kind:   KindVersion,
+			Action: ActionUpdate,
+			Name:   want.Name,
+			Diffs:  diffs,
+			apply: func(ctx context.Context, client *cloud.Client) error {
+				_, _, err := client.Version.Update(ctx, version)
+				return err
+			},
+		})
+	}
+	return changes, nil
+}
+
+// appendDiff appends the diff of a field if want is set and differs from have.
+func appendDiff(diffs []Diff, field, have, want string) []Diff {
+	if want == "" || want == have {
+		return diffs
+	}
+	return append(diffs, Diff{Field: field, From: have, To: want})
+}
+
+// appendBoolDiff appends the diff of a boolean field if want is set and differs from have.
+// Jira omits false values, so a missing value is false.
+func appendBoolDiff(diffs []Diff, field string, have, want *bool) []Diff {
+	if want == nil || *want == (have != nil && *have) {
+		return diffs
+	}
+	return append(diffs, Diff{Field: field, From: strconv.FormatBool(!*want), To: strconv.FormatBool(*want)})
+}
+
+// findGroupID returns the ID of the group with the given name.
+func findGroupID(ctx context.Context, client *cloud.Client, name string) (string, error) {
+	groups, _, err := client.Group.Find(ctx, cloud.WithGroupNameContains(url.QueryEscape(name)))
+	if err != nil {
+		return "", err
+	}
+	for _, g := range groups {
+		if strings.EqualFold(g.Name, name) {
+			return g.ID, nil
+		}
+	}
+	return "", fmt.Errorf("group %q not found", name)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
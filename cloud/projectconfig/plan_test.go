@@ -0,0 +1,285 @@
+package projectconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/conductorone/go-jira/v2/cloud"
+)
+
+// testJira is a Jira Cloud with the project EX, which records the mutating requests.
+type testJira struct {
+	mu       sync.Mutex
+	requests []string
+	// fail answers requests to this path with 500 Internal Server Error.
+	fail string
+	// group is the name of the group in the role Developers, it defaults to "old-group".
+	group string
+}
+
+func (j *testJira) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		body, _ := io.ReadAll(r.Body)
+		j.mu.Lock()
+		j.requests = append(j.requests, strings.TrimSpace(r.Method+" "+r.URL.RequestURI()+" "+string(body)))
+		j.mu.Unlock()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path == j.fail {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"errorMessages":["boom"]}`)
+		return
+	}
+
+	group := j.group
+	if group == "" {
+		group = "old-group"
+	}
+	switch r.Method + " " + r.URL.Path {
+	case "GET /rest/api/2/project/EX":
+		fmt.Fprint(w, `{"id":"10000","key":"EX",
+			"components":[{"id":"20000","name":"Backend","description":"Old","lead":{"accountId":"a1"},"assigneeType":"COMPONENT_LEAD"}],
+			"versions":[{"id":"30000","name":"1.0","released":false}]}`)
+	case "GET /rest/api/2/issueLinkType":
+		fmt.Fprint(w, `{"issueLinkTypes":[{"id":"40000","name":"Blocks","inward":"is blocked by","outward":"blocks"}]}`)
+	case "GET /rest/api/3/permissionscheme":
+		fmt.Fprint(w, `{"permissionSchemes":[{"id":0,"name":"Default"},{"id":10001,"name":"Strict"}]}`)
+	case "GET /rest/api/2/project/EX/permissionscheme":
+		fmt.Fprint(w, `{"id":0,"name":"Default"}`)
+	case "GET /rest/api/3/role":
+		fmt.Fprint(w, `[{"id":10002,"name":"Developers"}]`)
+	case "GET /rest/api/3/project/EX/role/10002":
+		fmt.Fprintf(w, `{"id":10002,"name":"Developers","actors":[
+			{"id":1,"type":"atlassian-user-role-actor","actorUser":{"accountId":"a1"}},
+			{"id":2,"type":"atlassian-group-role-actor","actorGroup":{"name":%q,"groupId":"g-old"}}]}`, group)
+	case "GET /rest/api/3/groups/picker":
+		fmt.Fprint(w, `{"groups":[{"name":"devs","groupId":"g-devs"}]}`)
+	case "POST /rest/api/3/project/EX/role/10002":
+		fmt.Fprint(w, `{"id":10002,"actors":[]}`)
+	case "POST /rest/api/2/issueLinkType", "POST /rest/api/3/component", "POST /rest/api/2/version":
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{}`)
+	case "PUT /rest/api/2/project/EX/permissionscheme":
+		fmt.Fprint(w, `{"id":10001,"name":"Strict"}`)
+	case "PUT /rest/api/3/component/20000", "PUT /rest/api/2/version/30000":
+		fmt.Fprint(w, `{}`)
+	case "DELETE /rest/api/3/project/EX/role/10002":
+		if name := r.URL.Query().Get("group"); name != "" && name != group {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"errorMessages":["group %s not found"]}`, name)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"errorMessages":["unexpected request %s %s"]}`, r.Method, r.URL.Path)
+	}
+}
+
+func newTestEngine(t *testing.T, jira *testJira) *Engine {
+	t.Helper()
+	server := httptest.NewServer(jira)
+	t.Cleanup(server.Close)
+	client, err := cloud.NewClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(client)
+}
+
+const testPlanConfig = `
+project: EX
+permissionScheme: Strict
+issueLinkTypes:
+  - {name: Blocks, inward: is blocked by, outward: blocks}
+  - {name: Duplicate, inward: is duplicated by, outward: duplicates}
+roles:
+  - role: Developers
+    users: [a1, a2]
+    groups: [devs]
+components:
+  - {name: Backend, description: API and workers, lead: a1}
+  - {name: Frontend}
+versions:
+  - {name: "1.0", released: true}
+  - {name: "2.0"}
+`
+
+func TestEngine_Plan(t *testing.T) {
+	engine := newTestEngine(t, &testJira{})
+	cfg, err := Parse([]byte(testPlanConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := engine.Plan(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := `Plan for project EX: 5 to create, 3 to update, 1 to delete
+  + issueLinkType "Duplicate"
+  ~ permissionScheme "Strict"
+      permissionScheme: "Default" -> "Strict"
+  + roleActor "Developers: user a2"
+  + roleActor "Developers: group devs"
+  - roleActor "Developers: group old-group"
+  ~ component "Backend"
+      description: "Old" -> "API and workers"
+  + component "Frontend"
+  ~ version "1.0"
+      released: "false" -> "true"
+  + version "2.0"
+`
+	if got := plan.String(); got != want {
+		t.Errorf("got plan\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestEngine_Plan_NoChanges(t *testing.T) {
+	engine := newTestEngine(t, &testJira{})
+	cfg, err := Parse([]byte(`{"project":"EX","permissionScheme":"0","components":[{"name":"Backend","description":"Old"}],"roles":[{"role":"Developers","users":["a1"],"groups":["old-group"]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := engine.Plan(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("expected an empty plan, got\n%s", plan)
+	}
+}
+
+func TestEngine_Plan_OnlyPrunesRoleActors(t *testing.T) {
+	engine := newTestEngine(t, &testJira{})
+	cfg, err := Parse([]byte(`{"project":"EX","components":[{"name":"Frontend"}],"versions":[{"name":"2.0"}],"roles":[{"role":"Developers"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := engine.Plan(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	// The component Backend and the version 1.0 are not in the Config, but only the role actors are removed
+	want := `Plan for project EX: 2 to create, 0 to update, 2 to delete
+  - roleActor "Developers: user a1"
+  - roleActor "Developers: group old-group"
+  + component "Frontend"
+  + version "2.0"
+`
+	if got := plan.String(); got != want {
+		t.Errorf("got plan\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestEngine_Apply(t *testing.T) {
+	jira := &testJira{}
+	engine := newTestEngine(t, jira)
+	cfg, err := Parse([]byte(testPlanConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := engine.Plan(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := engine.Apply(context.Background(), plan)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(results) != len(plan.Changes) {
+		t.Fatalf("expected %d results, got %d", len(plan.Changes), len(results))
+	}
+	for _, result := range results {
+		if result.Status != StatusApplied {
+			t.Errorf("%s: status %s, error %v", result.Change, result.Status, result.Err)
+		}
+	}
+
+	want := []string{
+		`POST /rest/api/2/issueLinkType {"name":"Duplicate","inward":"is duplicated by","outward":"duplicates"}`,
+		`PUT /rest/api/2/project/EX/permissionscheme {"id":10001}`,
+		`POST /rest/api/3/project/EX/role/10002 {"user":["a2"],"groupId":null}`,
+		`POST /rest/api/3/project/EX/role/10002 {"user":null,"groupId":["g-devs"]}`,
+		`DELETE /rest/api/3/project/EX/role/10002?group=old-group`,
+		`PUT /rest/api/3/component/20000 {"name":"Backend","description":"API and workers","leadAccountId":"a1"}`,
+		`POST /rest/api/3/component {"name":"Frontend","project":"EX"}`,
+		`PUT /rest/api/2/version/30000 {"id":"30000","name":"1.0","released":true}`,
+		`POST /rest/api/2/version {"name":"2.0","projectId":10000}`,
+	}
+	if got := strings.Join(jira.requests, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("got requests\n%s\nwant\n%s", got, strings.Join(want, "\n"))
+	}
+}
+
+func TestEngine_Apply_RemovesGroupWithSpecialCharacters(t *testing.T) {
+	jira := &testJira{group: "R&D team"}
+	engine := newTestEngine(t, jira)
+	cfg, err := Parse([]byte(`{"project":"EX","roles":[{"role":"Developers","users":["a1"]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := engine.Plan(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `Plan for project EX: 0 to create, 0 to update, 1 to delete
+  - roleActor "Developers: group R&D team"
+`
+	if got := plan.String(); got != want {
+		t.Errorf("got plan\n%s\nwant\n%s", got, want)
+	}
+
+	if _, err := engine.Apply(context.Background(), plan); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got, want := strings.Join(jira.requests, "\n"), `DELETE /rest/api/3/project/EX/role/10002?group=R%26D+team`; got != want {
+		t.Errorf("got requests\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestEngine_Apply_SkipsDependents(t *testing.T) {
+	jira := &testJira{}
+	engine := newTestEngine(t, jira)
+	cfg, err := Parse([]byte(testPlanConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := engine.Plan(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jira.fail = "/rest/api/3/component"
+	results, err := engine.Apply(context.Background(), plan)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	statuses := make(map[string]Status)
+	for _, result := range results {
+		statuses[result.Change.String()] = result.Status
+	}
+	if got := statuses[`+ component "Frontend"`]; got != StatusFailed {
+		t.Errorf("expected the component to fail, got %s", got)
+	}
+	if got := statuses[`~ component "Backend"`]; got != StatusApplied {
+		t.Errorf("expected the other component to be applied, got %s", got)
+	}
+	if got := statuses[`+ version "2.0"`]; got != StatusSkipped {
+		t.Errorf("expected the versions to be skipped, got %s", got)
+	}
+	var jerr *cloud.Error
+	if !errors.As(err, &jerr) {
+		t.Errorf("expected a Jira error, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // RoleService handles roles for the Jira instance / API.
@@ -145,7 +146,7 @@ func (s *RoleService) AddUserToRole(ctx context.Context, projectID string, roleI
 func (s *RoleService) RemoveUserFromRole(ctx context.Context, projectID string, roleID int, userID string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/role/%d", projectID, roleID)
 
-	apiEndpoint += "?user=" + userID
+	apiEndpoint += "?" + url.Values{"user": {userID}}.Encode()
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
@@ -173,20 +174,20 @@ func (s *RoleService) AddGroupToRole(ctx context.Context, projectID string, role
 		return nil, nil, err
 	}
 
-	var actors []*Actor
-	resp, err := s.client.Do(req, actors)
+	role := new(Role)
+	resp, err := s.client.Do(req, role)
 	if err != nil {
 		jerr := NewJiraError(resp, err)
 		return nil, resp, jerr
 	}
 
-	return actors, resp, nil
+	return role.Actors, resp, nil
 }
 
 func (s *RoleService) RemoveGroupFromRole(ctx context.Context, projectID string, roleID int, groupID string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/role/%d", projectID, roleID)
 
-	apiEndpoint += "?group=" + groupID
+	apiEndpoint += "?" + url.Values{"group": {groupID}}.Encode()
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
@@ -135,3 +135,56 @@ func TestRoleService_GetRoleActorsForProject(t *testing.T) {
 		t.Errorf("Expected Mia Krystof, got %s", actors[1].DisplayName)
 	}
 }
+
+func TestRoleService_AddGroupToRole(t *testing.T) {
+	setup()
+	defer teardown()
+	rawResponseBody, err := os.ReadFile("../testing/mock-data/role_actors.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testapiEndpoint := "/rest/api/3/project/10002/role/10006"
+	testMux.HandleFunc(testapiEndpoint, func(writer http.ResponseWriter, request *http.Request) {
+		testMethod(t, request, http.MethodPost)
+		testRequestURL(t, request, testapiEndpoint)
+		fmt.Fprint(writer, string(rawResponseBody))
+	})
+
+	actors, _, err := testClient.Role.AddGroupToRole(context.Background(), "10002", 10006, "952d12c3-5b5b-4d04-bb32-44d383afc4b2")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(actors) != 2 {
+		t.Errorf("Expected 2 actors, got %d", len(actors))
+	}
+}
+
+func TestRoleService_RemoveGroupFromRole(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/project/10002/role/10006"
+	testMux.HandleFunc(testapiEndpoint, func(writer http.ResponseWriter, request *http.Request) {
+		testMethod(t, request, http.MethodDelete)
+		testRequestParams(t, request, map[string]string{"group": "R&D team"})
+		writer.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Role.RemoveGroupFromRole(context.Background(), "10002", 10006, "R&D team"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestRoleService_RemoveUserFromRole(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/3/project/10002/role/10006"
+	testMux.HandleFunc(testapiEndpoint, func(writer http.ResponseWriter, request *http.Request) {
+		testMethod(t, request, http.MethodDelete)
+		testRequestParams(t, request, map[string]string{"user": "5b10ac8d82e05b22cc7d4ef5"})
+		writer.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Role.RemoveUserFromRole(context.Background(), "10002", 10006, "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
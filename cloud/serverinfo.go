@@ -0,0 +1,50 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// ServerInfoService handles the server information of the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-server-info/
+type ServerInfoService service
+
+// Deployment types of a Jira instance, as reported by ServerInfo.DeploymentType.
+const (
+	DeploymentTypeCloud      = "Cloud"
+	DeploymentTypeServer     = "Server"
+	DeploymentTypeDataCenter = "DataCenter"
+)
+
+// ServerInfo represents the version and deployment of a Jira instance.
+type ServerInfo struct {
+	BaseURL        string `json:"baseUrl" structs:"baseUrl"`
+	Version        string `json:"version" structs:"version"`
+	VersionNumbers []int  `json:"versionNumbers" structs:"versionNumbers"`
+	DeploymentType string `json:"deploymentType" structs:"deploymentType"`
+	BuildNumber    int    `json:"buildNumber" structs:"buildNumber"`
+	BuildDate      *Time  `json:"buildDate,omitempty" structs:"buildDate,omitempty"`
+	ServerTime     *Time  `json:"serverTime,omitempty" structs:"serverTime,omitempty"`
+	ScmInfo        string `json:"scmInfo" structs:"scmInfo"`
+	ServerTitle    string `json:"serverTitle" structs:"serverTitle"`
+}
+
+// Get returns the version, build number, deployment type and server time of the Jira instance.
+// It can be called without authentication.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-server-info/#api-rest-api-2-serverinfo-get
+func (s *ServerInfoService) Get(ctx context.Context) (*ServerInfo, *Response, error) {
+	apiEndpoint := "rest/api/2/serverInfo"
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := new(ServerInfo)
+	resp, err := s.client.Do(req, info)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return info, resp, nil
+}
@@ -0,0 +1,35 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestServerInfoService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/api/2/serverInfo"
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		fmt.Fprint(w, `{"baseUrl":"https://your-domain.atlassian.net","version":"1001.0.0-SNAPSHOT","versionNumbers":[1001,0,0],"deploymentType":"Cloud","buildNumber":100264,"buildDate":"2024-05-03T08:00:00.000+0000","serverTime":"2024-05-06T10:11:12.123+0000","scmInfo":"abc123","serverTitle":"Jira"}`)
+	})
+
+	info, _, err := testClient.ServerInfo.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if info.DeploymentType != DeploymentTypeCloud {
+		t.Errorf("Expected deployment type %s, got %s", DeploymentTypeCloud, info.DeploymentType)
+	}
+	if len(info.VersionNumbers) != 3 || info.VersionNumbers[0] != 1001 || info.BuildNumber != 100264 {
+		t.Errorf("Unexpected version %v, build %d", info.VersionNumbers, info.BuildNumber)
+	}
+	want := time.Date(2024, 5, 6, 10, 11, 12, 123000000, time.UTC)
+	if info.ServerTime == nil || !time.Time(*info.ServerTime).Equal(want) {
+		t.Errorf("Expected server time %s, got %v", want, info.ServerTime)
+	}
+}
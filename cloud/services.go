@@ -0,0 +1,578 @@
+// Code generated by servicegen. DO NOT EDIT.
+
+package cloud
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ApplicationRoleAPI is the interface of the ApplicationRoleService, so it can be replaced in tests.
+// See the ApplicationRoleService for the documentation of the methods.
+type ApplicationRoleAPI interface {
+	// Get returns the application role with the given key, like "jira-software".
+	Get(ctx context.Context, key string) (*ApplicationRole, *Response, error)
+
+	// GetList returns all application roles with their groups and seats.
+	GetList(ctx context.Context) ([]ApplicationRole, *Response, error)
+
+	// LicenseUtilization returns the license usage of all application roles.
+	LicenseUtilization(ctx context.Context) ([]LicenseUtilization, *Response, error)
+}
+
+// AuditAPI is the interface of the AuditService, so it can be replaced in tests.
+// See the AuditService for the documentation of the methods.
+type AuditAPI interface {
+	Get(ctx context.Context, opts *AuditOptions) (*AuditResponse, *Response, error)
+}
+
+// BoardAPI is the interface of the BoardService, so it can be replaced in tests.
+// See the BoardService for the documentation of the methods.
+type BoardAPI interface {
+	// CreateBoard creates a new board. Board name, type and filter Id is required.
+	CreateBoard(ctx context.Context, board *Board) (*Board, *Response, error)
+
+	// DeleteBoard will delete an agile board.
+	DeleteBoard(ctx context.Context, boardID int) (*Board, *Response, error)
+
+	// GetAllBoards will returns all boards. This only includes boards that the user has permission to view.
+	GetAllBoards(ctx context.Context, opt *BoardListOptions) (*BoardsList, *Response, error)
+
+	// GetAllSprints returns all sprints from a board, for a given board ID.
+	GetAllSprints(ctx context.Context, boardID int64, options *GetAllSprintsOptions) (*SprintsList, *Response, error)
+
+	// GetBoard returns the board for the given board ID.
+	GetBoard(ctx context.Context, boardID int64) (*Board, *Response, error)
+
+	// GetBoardConfiguration will return a board configuration for a given board Id
+	GetBoardConfiguration(ctx context.Context, boardID int) (*BoardConfiguration, *Response, error)
+}
+
+// ComponentAPI is the interface of the ComponentService, so it can be replaced in tests.
+// See the ComponentService for the documentation of the methods.
+type ComponentAPI interface {
+	// Create creates a component.
+	Create(ctx context.Context, options *ComponentCreateOptions) (*ProjectComponent, *Response, error)
+
+	// Get returns a component for the given componentID.
+	Get(ctx context.Context, componentID string) (*ProjectComponent, *Response, error)
+
+	// Update updates the fields of the component which are set in options.
+	Update(ctx context.Context, componentID string, options *ComponentCreateOptions) (*ProjectComponent, *Response, error)
+}
+
+// CustomerAPI is the interface of the CustomerService, so it can be replaced in tests.
+// See the CustomerService for the documentation of the methods.
+type CustomerAPI interface {
+	// Create creates a ServiceDesk customer.
+	Create(ctx context.Context, email string, displayName string) (*Customer, *Response, error)
+}
+
+// FieldAPI is the interface of the FieldService, so it can be replaced in tests.
+// See the FieldService for the documentation of the methods.
+type FieldAPI interface {
+	// GetList gets all fields from Jira
+	GetList(ctx context.Context) ([]Field, *Response, error)
+}
+
+// FilterAPI is the interface of the FilterService, so it can be replaced in tests.
+// See the FilterService for the documentation of the methods.
+type FilterAPI interface {
+	// Get retrieves a single Filter from Jira
+	Get(ctx context.Context, filterID int) (*Filter, *Response, error)
+
+	// GetFavouriteList retrieves the user's favourited filters from Jira
+	GetFavouriteList(ctx context.Context) ([]*Filter, *Response, error)
+
+	// GetList retrieves all filters from Jira
+	GetList(ctx context.Context) ([]*Filter, *Response, error)
+
+	// GetMyFilters retrieves the my Filters.
+	GetMyFilters(ctx context.Context, opts *GetMyFiltersQueryOptions) ([]*Filter, *Response, error)
+
+	// Search will search for filter according to the search options
+	Search(ctx context.Context, opt *FilterSearchOptions) (*FiltersList, *Response, error)
+}
+
+// GroupAPI is the interface of the GroupService, so it can be replaced in tests.
+// See the GroupService for the documentation of the methods.
+type GroupAPI interface {
+	// Add adds a user to a group.
+	AddUserByGroupId(ctx context.Context, groupId string, accountID string) (*Response, error)
+
+	// Add adds a user to a group.
+	AddUserByGroupName(ctx context.Context, groupName string, accountID string) (*Group, *Response, error)
+
+	// Bulk get groups
+	Bulk(ctx context.Context, tweaks ...UserSearchF) ([]BulkGroup, *Response, error)
+
+	// Search for the groups
+	Find(ctx context.Context, tweaks ...UserSearchF) ([]Group, *Response, error)
+
+	// Get returns a paginated list of members of the specified group and its subgroups.
+	Get(ctx context.Context, name string, options *GroupSearchOptions) ([]GroupMember, *Response, error)
+
+	// Search for the group members
+	GetGroupMembers(ctx context.Context, groupId string, tweaks ...UserSearchF) ([]GroupMember, *Response, error)
+
+	// Remove removes a user from a group using Group ID.
+	RemoveUserByGroupId(ctx context.Context, groupId string, accountID string) (*Response, error)
+
+	// Remove removes a user from a group.
+	RemoveUserByGroupName(ctx context.Context, groupName string, accountID string) (*Response, error)
+}
+
+// IssueLinkTypeAPI is the interface of the IssueLinkTypeService, so it can be replaced in tests.
+// See the IssueLinkTypeService for the documentation of the methods.
+type IssueLinkTypeAPI interface {
+	// Create creates an issue link type in Jira.
+	Create(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error)
+
+	// Delete deletes an issue link type based on provided ID.
+	Delete(ctx context.Context, ID string) (*Response, error)
+
+	// Get gets info of a specific issue link type from Jira.
+	Get(ctx context.Context, ID string) (*IssueLinkType, *Response, error)
+
+	// GetList gets all of the issue link types from Jira.
+	GetList(ctx context.Context) ([]IssueLinkType, *Response, error)
+
+	// Update updates an issue link type.  The issue is found by key.
+	Update(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error)
+}
+
+// IssueAPI is the interface of the IssueService, so it can be replaced in tests.
+// See the IssueService for the documentation of the methods.
+type IssueAPI interface {
+	// AddComment adds a new comment to issueID.
+	AddComment(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error)
+
+	// AddLink adds a link between two issues.
+	AddLink(ctx context.Context, issueLink *IssueLink) (*Response, error)
+
+	// AddRemoteLink adds a remote link to issueID.
+	AddRemoteLink(ctx context.Context, issueID string, remotelink *RemoteLink) (*RemoteLink, *Response, error)
+
+	// AddWatcher adds watcher to the given issue
+	AddWatcher(ctx context.Context, issueID string, userName string) (*Response, error)
+
+	// AddWorklogRecord adds a new worklog record to issueID.
+	AddWorklogRecord(ctx context.Context, issueID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error)
+
+	// Create creates an issue or a sub-task from a JSON representation.
+	Create(ctx context.Context, issue *Issue) (*Issue, *Response, error)
+
+	// Delete will delete a specified issue.
+	Delete(ctx context.Context, issueID string) (*Response, error)
+
+	// DeleteAttachment deletes an attachment of a given attachmentID
+	DeleteAttachment(ctx context.Context, attachmentID string) (*Response, error)
+
+	// DeleteComment Deletes a comment from an issueID.
+	DeleteComment(ctx context.Context, issueID string, commentID string) error
+
+	// DeleteLink deletes a link of a given linkID
+	DeleteLink(ctx context.Context, linkID string) (*Response, error)
+
+	// DoTransition performs a transition on an issue.
+	DoTransition(ctx context.Context, ticketID string, transitionID string) (*Response, error)
+
+	// DoTransitionWithPayload performs a transition on an issue using any payload.
+	DoTransitionWithPayload(ctx context.Context, ticketID interface{}, payload interface{}) (*Response, error)
+
+	// DownloadAttachment returns a Response of an attachment for a given attachmentID.
+	DownloadAttachment(ctx context.Context, attachmentID string) (*Response, error)
+
+	// DownloadAttachmentTo streams the content of an attachment for a given attachmentID into w.
+	DownloadAttachmentTo(ctx context.Context, attachmentID string, w io.Writer, options *DownloadAttachmentOptions) (*Attachment, *Response, error)
+
+	// Get returns a full representation of the issue for the given issue key.
+	Get(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error)
+
+	// GetAttachment returns the metadata of an attachment for a given attachmentID.
+	GetAttachment(ctx context.Context, attachmentID string) (*Attachment, *Response, error)
+
+	// GetCreateMeta makes the api call to get the meta information without requiring to have a projectKey
+	GetCreateMeta(ctx context.Context, options *GetQueryOptions) (*CreateMetaInfo, *Response, error)
+	GetCreateMetaIssueType(ctx context.Context, projectKey string, issueTypeId string, options *GetQueryIssueTypeOptions) ([]*MetaDataFields, *Response, error)
+
+	// GetCustomFields returns a map of customfield_* keys with string values
+	GetCustomFields(ctx context.Context, issueID string) (CustomFields, *Response, error)
+
+	// GetEditMeta makes the api call to get the edit meta information for an issue
+	GetEditMeta(ctx context.Context, issue *Issue) (*EditMetaInfo, *Response, error)
+
+	// GetRemoteLinks gets remote issue links on the issue.
+	GetRemoteLinks(ctx context.Context, id string) (*[]RemoteLink, *Response, error)
+
+	// GetTransitions gets a list of the transitions possible for this issue by the current user,
+	GetTransitions(ctx context.Context, id string) ([]Transition, *Response, error)
+
+	// GetWatchers wil return all the users watching/observing the given issue
+	GetWatchers(ctx context.Context, issueID string) (*[]User, *Response, error)
+
+	// GetWorklogs gets all the worklogs for an issue.
+	GetWorklogs(ctx context.Context, issueID string, options ...func(*http.Request) error) (*Worklog, *Response, error)
+
+	// PostAttachment uploads r (io.Reader) as an attachment to a given issueID
+	PostAttachment(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error)
+
+	// PostAttachments uploads one or more files as attachments to a given issueID.
+	PostAttachments(ctx context.Context, issueID string, files []AttachmentFile, options *PostAttachmentOptions) (*[]Attachment, *Response, error)
+
+	// RemoveWatcher removes given user from given issue
+	RemoveWatcher(ctx context.Context, issueID string, userName string) (*Response, error)
+
+	// Search will search for tickets according to the jql
+	Search(ctx context.Context, jql string, options *SearchOptions) ([]Issue, *Response, error)
+
+	// SearchPages will get issues from all pages in a search
+	SearchPages(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) error
+
+	// Update updates an issue from a JSON representation,
+	Update(ctx context.Context, issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error)
+
+	// UpdateAssignee updates the user assigned to work on the given issue
+	UpdateAssignee(ctx context.Context, issueID string, assignee *User) (*Response, error)
+
+	// UpdateComment updates the body of a comment, identified by comment.ID, on the issueID.
+	UpdateComment(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error)
+
+	// UpdateIssue updates an issue from a JSON representation. The issue is found by key.
+	UpdateIssue(ctx context.Context, jiraID string, data map[string]interface{}) (*Response, error)
+
+	// UpdateRemoteLink updates a remote issue link by linkID.
+	UpdateRemoteLink(ctx context.Context, issueID string, linkID int, remotelink *RemoteLink) (*Response, error)
+
+	// UpdateWorklogRecord updates a worklog record.
+	UpdateWorklogRecord(ctx context.Context, issueID string, worklogID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error)
+
+	// UpsertByExternalID updates the issue of the project which has the external ID,
+	UpsertByExternalID(ctx context.Context, project string, externalID string, issue *Issue, options ...UpsertOption) (*UpsertResult, *Response, error)
+}
+
+// OrganizationAPI is the interface of the OrganizationService, so it can be replaced in tests.
+// See the OrganizationService for the documentation of the methods.
+type OrganizationAPI interface {
+	// AddUsers adds users to an organization.
+	AddUsers(ctx context.Context, organizationID int, users OrganizationUsersDTO) (*Response, error)
+
+	// CreateOrganization creates an organization by
+	CreateOrganization(ctx context.Context, name string) (*Organization, *Response, error)
+
+	// DeleteOrganization deletes an organization. Note that
+	DeleteOrganization(ctx context.Context, organizationID int) (*Response, error)
+
+	// DeleteProperty removes a property from an organization.
+	DeleteProperty(ctx context.Context, organizationID int, propertyKey string) (*Response, error)
+
+	// GetAllOrganizations returns a list of organizations in
+	GetAllOrganizations(ctx context.Context, start int, limit int, accountID string) (*PagedDTO, *Response, error)
+
+	// GetOrganization returns details of an
+	GetOrganization(ctx context.Context, organizationID int) (*Organization, *Response, error)
+
+	// GetPropertiesKeys returns the keys of
+	GetPropertiesKeys(ctx context.Context, organizationID int) (*PropertyKeys, *Response, error)
+
+	// GetProperty returns the value of a property
+	GetProperty(ctx context.Context, organizationID int, propertyKey string) (*EntityProperty, *Response, error)
+
+	// GetUsers returns all the users
+	GetUsers(ctx context.Context, organizationID int, start int, limit int) (*PagedDTO, *Response, error)
+
+	// RemoveUsers removes users from an organization.
+	RemoveUsers(ctx context.Context, organizationID int, users OrganizationUsersDTO) (*Response, error)
+
+	// SetProperty sets the value of a
+	SetProperty(ctx context.Context, organizationID int, propertyKey string, value interface{}) (*Response, error)
+}
+
+// PermissionSchemeAPI is the interface of the PermissionSchemeService, so it can be replaced in tests.
+// See the PermissionSchemeService for the documentation of the methods.
+type PermissionSchemeAPI interface {
+	// Get returns a full representation of the permission scheme for the schemeID
+	Get(ctx context.Context, schemeID int) (*PermissionScheme, *Response, error)
+
+	// GetList returns a list of all permission schemes
+	GetList(ctx context.Context) (*PermissionSchemes, *Response, error)
+}
+
+// PriorityAPI is the interface of the PriorityService, so it can be replaced in tests.
+// See the PriorityService for the documentation of the methods.
+type PriorityAPI interface {
+	// GetList gets all priorities from Jira
+	GetList(ctx context.Context) ([]Priority, *Response, error)
+}
+
+// ProjectCategoryAPI is the interface of the ProjectCategoryService, so it can be replaced in tests.
+// See the ProjectCategoryService for the documentation of the methods.
+type ProjectCategoryAPI interface {
+	// Create creates a project category with the given name and description.
+	Create(ctx context.Context, name string, description string) (*ProjectCategory, *Response, error)
+
+	// Delete deletes the project category with the given ID. Its projects keep existing without a category.
+	Delete(ctx context.Context, categoryID string) (*Response, error)
+
+	// Get returns the project category with the given ID.
+	Get(ctx context.Context, categoryID string) (*ProjectCategory, *Response, error)
+
+	// GetList returns all project categories.
+	GetList(ctx context.Context) ([]ProjectCategory, *Response, error)
+
+	// Update changes the name and description of the project category with the given ID.
+	Update(ctx context.Context, categoryID string, name string, description string) (*ProjectCategory, *Response, error)
+}
+
+// ProjectAPI is the interface of the ProjectService, so it can be replaced in tests.
+// See the ProjectService for the documentation of the methods.
+type ProjectAPI interface {
+	// Archive archives the project. Archived projects are read-only and can be restored with Restore.
+	Archive(ctx context.Context, projectIDOrKey string) (*Response, error)
+
+	// AssignIssueSecurityScheme assigns the issue security scheme with the given ID to the project.
+	AssignIssueSecurityScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*Project, *Response, error)
+
+	// AssignNotificationScheme assigns the notification scheme with the given ID to the project.
+	AssignNotificationScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*Project, *Response, error)
+
+	// AssignPermissionScheme assigns the permission scheme with the given ID to the project.
+	AssignPermissionScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*PermissionScheme, *Response, error)
+
+	// Create creates a project from a template with the given lead and schemes.
+	Create(ctx context.Context, project *ProjectDetails) (*ProjectIdentifiers, *Response, error)
+
+	// Delete moves the project to the trash, from where it can be restored with Restore
+	Delete(ctx context.Context, projectIDOrKey string) (*Response, error)
+
+	// Find searches for project paginated info from Jira
+	Find(ctx context.Context, tweaks ...UserSearchF) ([]Project, *Response, error)
+
+	// Get returns a full representation of the project for the given issue key.
+	Get(ctx context.Context, projectID string) (*Project, *Response, error)
+
+	// GetAll returns all projects form Jira with optional query params, like &GetQueryOptions{Expand: "issueTypes"} to get
+	GetAll(ctx context.Context, options *GetQueryOptions) (*ProjectList, *Response, error)
+
+	// GetPermissionScheme returns a full representation of the permission scheme for the project
+	GetPermissionScheme(ctx context.Context, projectID string) (*PermissionScheme, *Response, error)
+
+	// Restore restores an archived project or a project from the trash.
+	Restore(ctx context.Context, projectIDOrKey string) (*Response, error)
+
+	// Update changes the fields of the project which are set in project, like the name, lead or category.
+	Update(ctx context.Context, projectIDOrKey string, project *ProjectDetails) (*Project, *Response, error)
+}
+
+// PropertyAPI is the interface of the PropertyService, so it can be replaced in tests.
+// See the PropertyService for the documentation of the methods.
+type PropertyAPI interface {
+	// Delete removes the property of an entity with the given key.
+	Delete(ctx context.Context, entity PropertyEntity, entityID string, key string) (*Response, error)
+
+	// Get returns the property of an entity with the given key.
+	Get(ctx context.Context, entity PropertyEntity, entityID string, key string) (*Property, *Response, error)
+
+	// GetKeys returns the keys of all properties of an entity.
+	GetKeys(ctx context.Context, entity PropertyEntity, entityID string) ([]PropertyKey, *Response, error)
+
+	// Set stores value as JSON in the property of an entity with the given key.
+	Set(ctx context.Context, entity PropertyEntity, entityID string, key string, value interface{}) (*Response, error)
+
+	// SetOnIssues stores value as JSON in the property with the given key of all issues matching the filter.
+	SetOnIssues(ctx context.Context, key string, value interface{}, filter *BulkPropertyFilter) (*Response, error)
+}
+
+// RequestAPI is the interface of the RequestService, so it can be replaced in tests.
+// See the RequestService for the documentation of the methods.
+type RequestAPI interface {
+	// Create creates a new request.
+	Create(ctx context.Context, requester string, participants []string, request *Request) (*Request, *Response, error)
+
+	// CreateComment creates a comment on a request.
+	CreateComment(ctx context.Context, issueIDOrKey string, comment *RequestComment) (*RequestComment, *Response, error)
+}
+
+// ResolutionAPI is the interface of the ResolutionService, so it can be replaced in tests.
+// See the ResolutionService for the documentation of the methods.
+type ResolutionAPI interface {
+	// GetList gets all resolutions from Jira
+	GetList(ctx context.Context) ([]Resolution, *Response, error)
+}
+
+// RoleAPI is the interface of the RoleService, so it can be replaced in tests.
+// See the RoleService for the documentation of the methods.
+type RoleAPI interface {
+	AddGroupToRole(ctx context.Context, projectID string, roleID int, groupID string) ([]*Actor, *Response, error)
+	AddUserToRole(ctx context.Context, projectID string, roleID int, userID string) (*Response, error)
+
+	// Get retreives a single Role from Jira
+	Get(ctx context.Context, roleID int) (*Role, *Response, error)
+
+	// GetList returns a list of all available project roles
+	GetList(ctx context.Context) (*[]Role, *Response, error)
+
+	// Get role actors for project
+	GetRoleActorsForProject(ctx context.Context, projectID string, roleID int) ([]*Actor, *Response, error)
+	RemoveGroupFromRole(ctx context.Context, projectID string, roleID int, groupID string) (*Response, error)
+	RemoveUserFromRole(ctx context.Context, projectID string, roleID int, userID string) (*Response, error)
+}
+
+// ServerInfoAPI is the interface of the ServerInfoService, so it can be replaced in tests.
+// See the ServerInfoService for the documentation of the methods.
+type ServerInfoAPI interface {
+	// Get returns the version, build number, deployment type and server time of the Jira instance.
+	Get(ctx context.Context) (*ServerInfo, *Response, error)
+}
+
+// ServiceDeskAPI is the interface of the ServiceDeskService, so it can be replaced in tests.
+// See the ServiceDeskService for the documentation of the methods.
+type ServiceDeskAPI interface {
+	// AddCustomers adds customers to the given service desk.
+	AddCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*Response, error)
+
+	// AddOrganization adds an organization to
+	AddOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*Response, error)
+
+	// GetOrganizations returns a list of
+	GetOrganizations(ctx context.Context, serviceDeskID interface{}, start int, limit int, accountID string) (*PagedDTO, *Response, error)
+
+	// ListCustomers lists customers for a ServiceDesk.
+	ListCustomers(ctx context.Context, serviceDeskID interface{}, options *CustomerListOptions) (*CustomerList, *Response, error)
+
+	// RemoveCustomers removes customers to the given service desk.
+	RemoveCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*Response, error)
+
+	// RemoveOrganization removes an organization
+	RemoveOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*Response, error)
+}
+
+// SprintAPI is the interface of the SprintService, so it can be replaced in tests.
+// See the SprintService for the documentation of the methods.
+type SprintAPI interface {
+	// GetIssue returns a full representation of the issue for the given issue key.
+	GetIssue(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error)
+
+	// GetIssuesForSprint returns all issues in a sprint, for a given sprint Id.
+	GetIssuesForSprint(ctx context.Context, sprintID int) ([]Issue, *Response, error)
+
+	// MoveIssuesToSprint moves issues to a sprint, for a given sprint Id.
+	MoveIssuesToSprint(ctx context.Context, sprintID int, issueIDs []string) (*Response, error)
+}
+
+// StatusCategoryAPI is the interface of the StatusCategoryService, so it can be replaced in tests.
+// See the StatusCategoryService for the documentation of the methods.
+type StatusCategoryAPI interface {
+	// Get returns a status category.
+	Get(ctx context.Context, statusCategoryID string) (*StatusCategory, *Response, error)
+
+	// GetList returns a list of all status categories.
+	GetList(ctx context.Context) ([]StatusCategory, *Response, error)
+}
+
+// StatusAPI is the interface of the StatusService, so it can be replaced in tests.
+// See the StatusService for the documentation of the methods.
+type StatusAPI interface {
+	// GetAllStatuses returns a list of all statuses associated with workflows.
+	GetAllStatuses(ctx context.Context) ([]Status, *Response, error)
+	SearchStatusesPaginated(ctx context.Context, tweaks ...UserSearchF) ([]JiraStatus, *Response, error)
+}
+
+// UserAPI is the interface of the UserService, so it can be replaced in tests.
+// See the UserService for the documentation of the methods.
+type UserAPI interface {
+	// Create creates an user in Jira.
+	Create(ctx context.Context, user *User) (*User, *Response, error)
+
+	// Delete deletes an user from Jira.
+	Delete(ctx context.Context, accountId string) (*Response, error)
+
+	// Find searches for user info from Jira:
+	Find(ctx context.Context, property string, tweaks ...UserSearchF) ([]User, *Response, error)
+
+	// FindUsersWithBrowsePermission searches for users with browse permission
+	FindUsersWithBrowsePermission(ctx context.Context, property string, tweaks ...UserSearchF) ([]User, *Response, error)
+
+	// Get gets user info from Jira using its Account Id
+	Get(ctx context.Context, accountId string) (*User, *Response, error)
+
+	// GetAccountIDs returns the account IDs of the users with the given user names and keys,
+	GetAccountIDs(ctx context.Context, usernames []string, keys []string) ([]UserMigration, *Response, error)
+
+	// GetByAccountID gets user info from Jira
+	GetByAccountID(ctx context.Context, accountID string) (*User, *Response, error)
+
+	// GetCurrentUser returns details for the current user.
+	GetCurrentUser(ctx context.Context) (*User, *Response, error)
+
+	// GetGroups returns the groups which the user belongs to
+	GetGroups(ctx context.Context, accountId string) (*[]UserGroup, *Response, error)
+
+	// GetMany returns the users with the given account IDs.
+	GetMany(ctx context.Context, accountIDs []string) ([]User, *Response, error)
+}
+
+// VersionAPI is the interface of the VersionService, so it can be replaced in tests.
+// See the VersionService for the documentation of the methods.
+type VersionAPI interface {
+	// Create creates a version in Jira.
+	Create(ctx context.Context, version *Version) (*Version, *Response, error)
+
+	// Get gets version info from Jira
+	Get(ctx context.Context, versionID int) (*Version, *Response, error)
+
+	// Update updates a version from a JSON representation.
+	Update(ctx context.Context, version *Version) (*Version, *Response, error)
+}
+
+// WebhookAPI is the interface of the WebhookService, so it can be replaced in tests.
+// See the WebhookService for the documentation of the methods.
+type WebhookAPI interface {
+	// Delete removes the webhooks with the given IDs.
+	Delete(ctx context.Context, ids ...int) (*Response, error)
+
+	// GetAll returns a page of webhooks registered by the calling app.
+	GetAll(ctx context.Context, options *WebhookListOptions) ([]Webhook, *Response, error)
+
+	// GetFailed returns webhook deliveries that failed and were not retried successfully.
+	GetFailed(ctx context.Context, options *FailedWebhookOptions) (*FailedWebhooks, *Response, error)
+
+	// Refresh extends the expiration of the webhooks with the given IDs.
+	Refresh(ctx context.Context, ids ...int) (time.Time, *Response, error)
+
+	// Register registers webhooks that are sent to the given url.
+	Register(ctx context.Context, url string, webhooks []WebhookDetails) ([]WebhookRegistrationResult, *Response, error)
+}
+
+// Compile-time checks that the services implement their interfaces.
+var (
+	_ ApplicationRoleAPI  = (*ApplicationRoleService)(nil)
+	_ AuditAPI            = (*AuditService)(nil)
+	_ BoardAPI            = (*BoardService)(nil)
+	_ ComponentAPI        = (*ComponentService)(nil)
+	_ CustomerAPI         = (*CustomerService)(nil)
+	_ FieldAPI            = (*FieldService)(nil)
+	_ FilterAPI           = (*FilterService)(nil)
+	_ GroupAPI            = (*GroupService)(nil)
+	_ IssueLinkTypeAPI    = (*IssueLinkTypeService)(nil)
+	_ IssueAPI            = (*IssueService)(nil)
+	_ OrganizationAPI     = (*OrganizationService)(nil)
+	_ PermissionSchemeAPI = (*PermissionSchemeService)(nil)
+	_ PriorityAPI         = (*PriorityService)(nil)
+	_ ProjectCategoryAPI  = (*ProjectCategoryService)(nil)
+	_ ProjectAPI          = (*ProjectService)(nil)
+	_ PropertyAPI         = (*PropertyService)(nil)
+	_ RequestAPI          = (*RequestService)(nil)
+	_ ResolutionAPI       = (*ResolutionService)(nil)
+	_ RoleAPI             = (*RoleService)(nil)
+	_ ServerInfoAPI       = (*ServerInfoService)(nil)
+	_ ServiceDeskAPI      = (*ServiceDeskService)(nil)
+	_ SprintAPI           = (*SprintService)(nil)
+	_ StatusCategoryAPI   = (*StatusCategoryService)(nil)
+	_ StatusAPI           = (*StatusService)(nil)
+	_ UserAPI             = (*UserService)(nil)
+	_ VersionAPI          = (*VersionService)(nil)
+	_ WebhookAPI          = (*WebhookService)(nil)
+)
@@ -0,0 +1,30 @@
+package cloud
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestServices_Interfaces ensures the interfaces cover all exported methods of the services.
+// Run go generate after adding a method to a service.
+func TestServices_Interfaces(t *testing.T) {
+	c, err := NewClient(testJiraInstanceURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() || field.Type.Kind() != reflect.Interface {
+			continue
+		}
+		service := v.Field(i).Elem().Type()
+		for j := 0; j < service.NumMethod(); j++ {
+			method := service.Method(j)
+			if _, ok := field.Type.MethodByName(method.Name); !ok {
+				t.Errorf("%s misses the method %s of %s", field.Type.Name(), method.Name, service)
+			}
+		}
+	}
+}
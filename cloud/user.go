@@ -64,8 +64,17 @@ type ApplicationRole struct {
 	HasUnlimitedSeats    bool     `json:"hasUnlimitedSeats"`
 	Platform             bool     `json:"platform"`
 
-	// Key `groupDetails` missing - https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-application-roles/#api-rest-api-3-applicationrole-key-get
-	// Key `defaultGroupsDetails` missing - https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-application-roles/#api-rest-api-3-applicationrole-key-get
+	// GroupDetails are the groups of Groups with their IDs.
+	GroupDetails []GroupName `json:"groupDetails,omitempty"`
+	// DefaultGroupsDetails are the groups of DefaultGroups with their IDs.
+	DefaultGroupsDetails []GroupName `json:"defaultGroupsDetails,omitempty"`
+}
+
+// GroupName identifies a group by its name and ID.
+type GroupName struct {
+	Name    string `json:"name,omitempty" structs:"name,omitempty"`
+	GroupID string `json:"groupId,omitempty" structs:"groupId,omitempty"`
+	Self    string `json:"self,omitempty" structs:"self,omitempty"`
 }
 
 type UserSearchParam struct {
@@ -79,11 +88,17 @@ type UserSearchF func(UserSearch) UserSearch
 
 // Get gets user info from Jira using its Account Id
 //
+// If the Client has a UserCache, a cached user is returned with a nil Response.
+//
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-user-get
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *UserService) Get(ctx context.Context, accountId string) (*User, *Response, error) {
+	if user, ok := s.client.UserCache.Get(accountId); ok {
+		return user, nil, nil
+	}
+
 	apiEndpoint := fmt.Sprintf("/rest/api/2/user?accountId=%s", accountId)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
@@ -95,17 +110,23 @@ func (s *UserService) Get(ctx context.Context, accountId string) (*User, *Respon
 	if err != nil {
 		return nil, resp, NewJiraError(resp, err)
 	}
+	s.client.UserCache.Add(*user)
 	return user, resp, nil
 }
 
 // GetByAccountID gets user info from Jira
 // Searching by another parameter that is not accountId is deprecated,
 // but this method is kept for backwards compatibility
+// If the Client has a UserCache, a cached user is returned with a nil Response.
 // Jira API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-getUser
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *UserService) GetByAccountID(ctx context.Context, accountID string) (*User, *Response, error) {
+	if user, ok := s.client.UserCache.Get(accountID); ok {
+		return user, nil, nil
+	}
+
 	apiEndpoint := fmt.Sprintf("/rest/api/2/user?accountId=%s", accountID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
@@ -117,6 +138,7 @@ func (s *UserService) GetByAccountID(ctx context.Context, accountID string) (*Us
 	if err != nil {
 		return nil, resp, NewJiraError(resp, err)
 	}
+	s.client.UserCache.Add(*user)
 	return user, resp, nil
 }
 
@@ -167,6 +189,7 @@ func (s *UserService) Delete(ctx context.Context, accountId string) (*Response,
 	if err != nil {
 		return resp, NewJiraError(resp, err)
 	}
+	s.client.UserCache.Remove(accountId)
 	return resp, nil
 }
 
@@ -206,7 +229,7 @@ func (s *UserService) GetCurrentUser(ctx context.Context) (*User, *Response, err
 	if err != nil {
 		return nil, resp, NewJiraError(resp, err)
 	}
-
+	s.client.UserCache.Add(user)
 	return &user, resp, nil
 }
 
@@ -300,6 +323,7 @@ func (s *UserService) Find(ctx context.Context, property string, tweaks ...UserS
 	if err != nil {
 		return nil, resp, NewJiraError(resp, err)
 	}
+	s.client.UserCache.Add(users...)
 	return users, resp, nil
 }
 
@@ -334,5 +358,6 @@ func (s *UserService) FindUsersWithBrowsePermission(ctx context.Context, propert
 	if err != nil {
 		return nil, resp, NewJiraError(resp, err)
 	}
+	s.client.UserCache.Add(users...)
 	return users, resp, nil
 }
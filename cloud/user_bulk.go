@@ -0,0 +1,315 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// userBulkChunkSize is the number of account IDs, user names or keys sent per bulk request,
+// which keeps the URLs of the requests short.
+const userBulkChunkSize = 100
+
+// UserMigration maps the user name and key of a user, as used by Jira Server / Data Center
+// and the deprecated Cloud APIs, to the account ID.
+type UserMigration struct {
+	Username  string `json:"username,omitempty" structs:"username,omitempty"`
+	Key       string `json:"key,omitempty" structs:"key,omitempty"`
+	AccountID string `json:"accountId,omitempty" structs:"accountId,omitempty"`
+}
+
+// userPage is a page of users returned by the bulk endpoint.
+type userPage struct {
+	StartAt    int    `json:"startAt"`
+	MaxResults int    `json:"maxResults"`
+	Total      int    `json:"total"`
+	IsLast     bool   `json:"isLast"`
+	Values     []User `json:"values"`
+}
+
+// GetMany returns the users with the given account IDs.
+//
+// The account IDs are sent in chunks and all pages of each chunk are fetched,
+// so any number of account IDs can be passed. Duplicates are looked up once.
+// Unknown account IDs are skipped; the users are returned in the order of their first account ID.
+//
+// If the Client has a UserCache, cached users are not fetched again and fetched users are added to it.
+// The returned Response is the one of the last request, or nil if all users were cached.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-users/#api-rest-api-3-user-bulk-get
+func (s *UserService) GetMany(ctx context.Context, accountIDs []string) ([]User, *Response, error) {
+	found := make(map[string]User, len(accountIDs))
+	var missing []string
+	for _, id := range dedupe(accountIDs) {
+		if user, ok := s.client.UserCache.Get(id); ok {
+			found[id] = *user
+			continue
+		}
+		missing = append(missing, id)
+	}
+
+	var resp *Response
+	for start := 0; start < len(missing); start += userBulkChunkSize {
+		chunk := missing[start:min(start+userBulkChunkSize, len(missing))]
+		var users []User
+		var err error
+		users, resp, err = s.getChunk(ctx, chunk)
+		if err != nil {
+			return nil, resp, err
+		}
+		s.client.UserCache.Add(users...)
+		for _, user := range users {
+			found[user.AccountID] = user
+		}
+	}
+
+	result := make([]User, 0, len(found))
+	for _, id := range dedupe(accountIDs) {
+		if user, ok := found[id]; ok {
+			result = append(result, user)
+		}
+	}
+	return result, resp, nil
+}
+
+// getChunk returns all pages of users with the given account IDs.
+func (s *UserService) getChunk(ctx context.Context, accountIDs []string) ([]User, *Response, error) {
+	var users []User
+	for startAt := 0; ; {
+		query := url.Values{"accountId": accountIDs}
+		query.Set("startAt", strconv.Itoa(startAt))
+		query.Set("maxResults", strconv.Itoa(userBulkChunkSize))
+		apiEndpoint := "rest/api/3/user/bulk?" + query.Encode()
+		req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		page := new(userPage)
+		resp, err := s.client.Do(req, page)
+		if err != nil {
+			return nil, resp, NewJiraError(resp, err)
+		}
+		users = append(users, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return users, resp, nil
+		}
+		startAt += len(page.Values)
+	}
+}
+
+// GetAccountIDs returns the account IDs of the users with the given user names and keys,
+// like the Name and Key of an onpremise.User after a migration to Cloud.
+//
+// The user names and keys are sent in chunks, so any number can be passed.
+// Unknown user names and keys are skipped.
+//
+// If the Client has a UserCache, known mappings are not fetched again and fetched mappings are added to it.
+// The returned Response is the one of the last request, or nil if all mappings were cached.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-users/#api-rest-api-3-user-bulk-migration-get
+func (s *UserService) GetAccountIDs(ctx context.Context, usernames, keys []string) ([]UserMigration, *Response, error) {
+	var result []UserMigration
+	var params []UserSearchParam
+	for _, username := range dedupe(usernames) {
+		if id, ok := s.client.UserCache.AccountIDByUsername(username); ok {
+			result = append(result, UserMigration{Username: username, AccountID: id})
+			continue
+		}
+		params = append(params, UserSearchParam{name: "username", value: username})
+	}
+	for _, key := range dedupe(keys) {
+		if id, ok := s.client.UserCache.AccountIDByKey(key); ok {
+			result = append(result, UserMigration{Key: key, AccountID: id})
+			continue
+		}
+		params = append(params, UserSearchParam{name: "key", value: key})
+	}
+
+	var resp *Response
+	for start := 0; start < len(params); start += userBulkChunkSize {
+		chunk := params[start:min(start+userBulkChunkSize, len(params))]
+		query := url.Values{}
+		for _, param := range chunk {
+			query.Add(param.name, param.value)
+		}
+		query.Set("maxResults", strconv.Itoa(len(chunk)))
+		apiEndpoint := "rest/api/3/user/bulk/migration?" + query.Encode()
+		req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var migrations []UserMigration
+		resp, err = s.client.Do(req, &migrations)
+		if err != nil {
+			return nil, resp, NewJiraError(resp, err)
+		}
+		s.client.UserCache.AddMigrations(migrations...)
+		result = append(result, migrations...)
+	}
+	return result, resp, nil
+}
+
+// dedupe returns the non-empty values in the order of their first occurrence.
+func dedupe(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	return result
+}
+
+// UserCache caches users by account ID and the account IDs of user names and keys,
+// evicting the least recently used entries and entries older than its time-to-live.
+// Set it as the UserCache of a Client to enable it.
+//
+// A UserCache is safe for concurrent use and can be shared by clients of the same Jira instance.
+// A nil *UserCache caches nothing.
+type UserCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	users      *lru[userCacheEntry[User]]
+	accountIDs *lru[userCacheEntry[string]]
+}
+
+// userCacheEntry is a cached value and the time it expires.
+type userCacheEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+// NewUserCache returns a UserCache holding up to size users and size user name and key mappings
+// for the duration ttl. If ttl is zero, entries only expire when they are evicted.
+func NewUserCache(size int, ttl time.Duration) *UserCache {
+	return &UserCache{
+		ttl:        ttl,
+		users:      newLRU[userCacheEntry[User]](size),
+		accountIDs: newLRU[userCacheEntry[string]](size),
+	}
+}
+
+// Get returns a copy of the cached user with the account ID.
+func (c *UserCache) Get(accountID string) (*User, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	user, ok := getUnexpired(c.users, accountID)
+	if !ok {
+		return nil, false
+	}
+	return &user, true
+}
+
+// AccountIDByUsername returns the cached account ID of the user name.
+func (c *UserCache) AccountIDByUsername(username string) (string, bool) {
+	return c.accountID("username:" + username)
+}
+
+// AccountIDByKey returns the cached account ID of the user key.
+func (c *UserCache) AccountIDByKey(key string) (string, bool) {
+	return c.accountID("key:" + key)
+}
+
+func (c *UserCache) accountID(key string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return getUnexpired(c.accountIDs, key)
+}
+
+// getUnexpired returns the value of the key, removing it if it expired.
+func getUnexpired[V any](l *lru[userCacheEntry[V]], key string) (V, bool) {
+	entry, ok := l.get(key)
+	if ok && !entry.expires.IsZero() && !time.Now().Before(entry.expires) {
+		l.delete(key)
+		ok = false
+	}
+	return entry.value, ok
+}
+
+// userCacheEntryOf returns the entry of value, expiring after the time-to-live of the cache.
+func userCacheEntryOf[V any](c *UserCache, value V) userCacheEntry[V] {
+	entry := userCacheEntry[V]{value: value}
+	if c.ttl > 0 {
+		entry.expires = time.Now().Add(c.ttl)
+	}
+	return entry
+}
+
+// Add caches the users by their account ID. Users without an account ID are ignored.
+func (c *UserCache) Add(users ...User) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, user := range users {
+		if user.AccountID != "" {
+			c.users.set(user.AccountID, userCacheEntryOf(c, user))
+		}
+	}
+}
+
+// AddMigrations caches the account IDs of the user names and keys.
+func (c *UserCache) AddMigrations(migrations ...UserMigration) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, m := range migrations {
+		if m.AccountID == "" {
+			continue
+		}
+		if m.Username != "" {
+			c.accountIDs.set("username:"+m.Username, userCacheEntryOf(c, m.AccountID))
+		}
+		if m.Key != "" {
+			c.accountIDs.set("key:"+m.Key, userCacheEntryOf(c, m.AccountID))
+		}
+	}
+}
+
+// Remove removes the user with the account ID from the cache.
+func (c *UserCache) Remove(accountID string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.users.delete(accountID)
+}
+
+// Clear removes all users and mappings from the cache.
+func (c *UserCache) Clear() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.users.clear()
+	c.accountIDs.clear()
+}
+
+// Len returns the number of cached users, including expired users which were not removed yet.
+func (c *UserCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.users.list.Len()
+}
@@ -0,0 +1,196 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestUserService_GetMany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	testMux.HandleFunc("/rest/api/3/user/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		ids := r.URL.Query()["accountId"]
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		// Answer with pages of 60 users
+		end := min(startAt+60, len(ids))
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":60,"isLast":%t,"values":[`, startAt, end == len(ids))
+		for i, id := range ids[startAt:end] {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"accountId":%q,"displayName":"User %s"}`, id, id)
+		}
+		fmt.Fprint(w, `]}`)
+	})
+
+	ids := make([]string, 0, 151)
+	for i := 0; i < 150; i++ {
+		ids = append(ids, fmt.Sprintf("id-%d", i))
+	}
+	ids = append(ids, "id-0")
+
+	users, _, err := testClient.User.GetMany(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 150 {
+		t.Fatalf("expected 150 users, got %d", len(users))
+	}
+	if users[0].AccountID != "id-0" || users[149].AccountID != "id-149" || users[149].DisplayName != "User id-149" {
+		t.Errorf("unexpected users %+v, %+v", users[0], users[149])
+	}
+	// 100 account IDs in 2 pages, then 50 in 1 page
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestUserService_GetMany_Cache(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.UserCache = NewUserCache(10, 0)
+	testClient.UserCache.Add(User{AccountID: "cached", DisplayName: "Cached"})
+
+	testMux.HandleFunc("/rest/api/3/user/bulk", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query()["accountId"]; !reflect.DeepEqual(got, []string{"fetched"}) {
+			t.Errorf("unexpected account IDs %v", got)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":100,"isLast":true,"values":[{"accountId":"fetched","displayName":"Fetched"}]}`)
+	})
+
+	users, _, err := testClient.User.GetMany(context.Background(), []string{"fetched", "cached"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(users) != 2 || users[0].DisplayName != "Fetched" || users[1].DisplayName != "Cached" {
+		t.Errorf("unexpected users %+v", users)
+	}
+	if user, ok := testClient.UserCache.Get("fetched"); !ok || user.DisplayName != "Fetched" {
+		t.Errorf("expected the fetched user to be cached, got %+v", user)
+	}
+
+	// All users are cached now, so no request is sent
+	users, resp, err := testClient.User.GetMany(context.Background(), []string{"cached", "fetched"})
+	if err != nil || resp != nil || len(users) != 2 {
+		t.Errorf("expected 2 cached users without a request, got %+v, %v, %v", users, resp, err)
+	}
+}
+
+func TestUserService_GetAccountIDs(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.UserCache = NewUserCache(10, 0)
+	testClient.UserCache.AddMigrations(UserMigration{Username: "cached", AccountID: "id-cached"})
+
+	testMux.HandleFunc("/rest/api/3/user/bulk/migration", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"username": "fred", "key": "JIRAUSER10100", "maxResults": "2"})
+		fmt.Fprint(w, `[{"username":"fred","accountId":"id-fred"},{"key":"JIRAUSER10100","accountId":"id-key"}]`)
+	})
+
+	migrations, _, err := testClient.User.GetAccountIDs(context.Background(), []string{"cached", "fred"}, []string{"JIRAUSER10100"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := []UserMigration{
+		{Username: "cached", AccountID: "id-cached"},
+		{Username: "fred", AccountID: "id-fred"},
+		{Key: "JIRAUSER10100", AccountID: "id-key"},
+	}
+	if !reflect.DeepEqual(migrations, want) {
+		t.Errorf("got %+v, want %+v", migrations, want)
+	}
+	if id, ok := testClient.UserCache.AccountIDByKey("JIRAUSER10100"); !ok || id != "id-key" {
+		t.Errorf("expected the key to be cached, got %q", id)
+	}
+}
+
+func TestUserCache_Evict(t *testing.T) {
+	cache := NewUserCache(2, 0)
+	cache.Add(User{AccountID: "a"}, User{AccountID: "b"})
+	cache.Get("a")
+	cache.Add(User{AccountID: "c"})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected the least recently used user to be evicted")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Error("expected a to be cached")
+	}
+	cache.Remove("a")
+	if cache.Len() != 1 {
+		t.Errorf("expected 1 cached user, got %d", cache.Len())
+	}
+
+	var nilCache *UserCache
+	nilCache.Add(User{AccountID: "a"})
+	if _, ok := nilCache.Get("a"); ok {
+		t.Error("expected a nil cache to cache nothing")
+	}
+}
+
+func TestUserCache_TTL(t *testing.T) {
+	cache := NewUserCache(10, time.Millisecond)
+	cache.Add(User{AccountID: "a"})
+	cache.AddMigrations(UserMigration{Username: "user-a", AccountID: "a"})
+	time.Sleep(2 * time.Millisecond)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Error("expected the user to expire")
+	}
+	if _, ok := cache.AccountIDByUsername("user-a"); ok {
+		t.Error("expected the user name to expire")
+	}
+	if cache.Len() != 0 {
+		t.Errorf("expected the expired user to be removed, got %d cached users", cache.Len())
+	}
+}
+
+func TestUserService_Get_UserCache(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.UserCache = NewUserCache(10, 0)
+
+	var requests int
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `[{"accountId":"found","displayName":"Found"}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"accountId":"fetched","displayName":"Fetched"}`)
+	})
+
+	if _, _, err := testClient.User.Find(context.Background(), "Found"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	user, resp, err := testClient.User.Get(context.Background(), "found")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.DisplayName != "Found" || resp != nil {
+		t.Errorf("expected the user found by the search to be cached, got %+v", user)
+	}
+
+	for i := 0; i < 2; i++ {
+		user, _, err = testClient.User.GetByAccountID(context.Background(), "fetched")
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if user.DisplayName != "Fetched" {
+			t.Errorf("expected the fetched user, got %+v", user)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
@@ -0,0 +1,237 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// WebhookService handles dynamic webhooks registered by Connect and OAuth 2.0 apps.
+// Dynamically registered webhooks expire after 30 days and must be refreshed, see WebhookRefresher.
+//
+// The webhook deliveries can be received with the github.com/conductorone/go-jira/v2/cloud/webhook package.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/
+type WebhookService service
+
+// WebhookTime is a point in time returned by the webhook API.
+// Jira sends it either as milliseconds since the epoch or as a formatted timestamp.
+type WebhookTime struct {
+	time.Time
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *WebhookTime) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		return nil
+	}
+
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		t.Time = time.UnixMilli(ms)
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return fmt.Errorf("WebhookTime unmarshal error: %w", err)
+	}
+	parsed, err := time.Parse("2006-01-02T15:04:05.000-0700", str)
+	if err != nil {
+		return fmt.Errorf("WebhookTime unmarshal error: %w", err)
+	}
+	t.Time = parsed
+	return nil
+}
+
+// WebhookDetails describes a webhook to register.
+type WebhookDetails struct {
+	// JQLFilter selects the issues the webhook is sent for.
+	// Only a subset of JQL is supported, see the Jira API docs.
+	JQLFilter string `json:"jqlFilter"`
+	// Events the webhook is sent for, like "jira:issue_created" or "comment_created".
+	Events                  []string `json:"events"`
+	FieldIDsFilter          []string `json:"fieldIdsFilter,omitempty"`
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"`
+}
+
+// Webhook is a registered dynamic webhook.
+type Webhook struct {
+	ID                      int          `json:"id"`
+	JQLFilter               string       `json:"jqlFilter"`
+	Events                  []string     `json:"events"`
+	FieldIDsFilter          []string     `json:"fieldIdsFilter,omitempty"`
+	IssuePropertyKeysFilter []string     `json:"issuePropertyKeysFilter,omitempty"`
+	ExpirationDate          *WebhookTime `json:"expirationDate,omitempty"`
+}
+
+// WebhookRegistrationResult is the result of registering a single webhook.
+// Either CreatedWebhookID or Errors is set.
+type WebhookRegistrationResult struct {
+	CreatedWebhookID int      `json:"createdWebhookId,omitempty"`
+	Errors           []string `json:"errors,omitempty"`
+}
+
+// WebhookListOptions specifies the optional parameters for WebhookService.GetAll.
+type WebhookListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// FailedWebhook is a webhook delivery that failed.
+type FailedWebhook struct {
+	ID          string      `json:"id"`
+	Body        string      `json:"body,omitempty"`
+	URL         string      `json:"url"`
+	FailureTime WebhookTime `json:"failureTime"`
+}
+
+// FailedWebhookOptions specifies the optional parameters for WebhookService.GetFailed.
+type FailedWebhookOptions struct {
+	MaxResults int `url:"maxResults,omitempty"`
+	// After returns only failures after this time, in milliseconds since the epoch.
+	After int64 `url:"after,omitempty"`
+}
+
+// FailedWebhooks is a page of failed webhook deliveries.
+type FailedWebhooks struct {
+	Values     []FailedWebhook `json:"values"`
+	MaxResults int             `json:"maxResults"`
+	// Next is the URL of the next page. It is empty on the last page.
+	Next string `json:"next,omitempty"`
+}
+
+type webhookRegistration struct {
+	Webhooks []WebhookDetails `json:"webhooks"`
+	URL      string           `json:"url"`
+}
+
+type webhookRegistrationResponse struct {
+	Results []WebhookRegistrationResult `json:"webhookRegistrationResult"`
+}
+
+type webhookIDs struct {
+	WebhookIDs []int `json:"webhookIds"`
+}
+
+type webhookRefreshResponse struct {
+	ExpirationDate WebhookTime `json:"expirationDate"`
+}
+
+// webhookListResult is the paginated response of WebhookService.GetAll
+type webhookListResult struct {
+	IsLast     bool      `json:"isLast"`
+	MaxResults int       `json:"maxResults"`
+	StartAt    int       `json:"startAt"`
+	Total      int       `json:"total"`
+	Values     []Webhook `json:"values"`
+}
+
+// Register registers webhooks that are sent to the given url.
+// The url must match the base URL of the app.
+// The results are in the same order as webhooks.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-post
+func (s *WebhookService) Register(ctx context.Context, url string, webhooks []WebhookDetails) ([]WebhookRegistrationResult, *Response, error) {
+	apiEndpoint := "rest/api/3/webhook"
+	body := webhookRegistration{Webhooks: webhooks, URL: url}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(webhookRegistrationResponse)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result.Results, resp, nil
+}
+
+// GetAll returns a page of webhooks registered by the calling app.
+// Paging information is available in the returned Response.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-get
+func (s *WebhookService) GetAll(ctx context.Context, options *WebhookListOptions) ([]Webhook, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/3/webhook", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(webhookListResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result.Values, resp, nil
+}
+
+// Delete removes the webhooks with the given IDs.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-delete
+func (s *WebhookService) Delete(ctx context.Context, ids ...int) (*Response, error) {
+	apiEndpoint := "rest/api/3/webhook"
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, webhookIDs{WebhookIDs: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Refresh extends the expiration of the webhooks with the given IDs.
+// It returns the new expiration date.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-refresh-put
+func (s *WebhookService) Refresh(ctx context.Context, ids ...int) (time.Time, *Response, error) {
+	apiEndpoint := "rest/api/3/webhook/refresh"
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, webhookIDs{WebhookIDs: ids})
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	result := new(webhookRefreshResponse)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return time.Time{}, resp, NewJiraError(resp, err)
+	}
+
+	return result.ExpirationDate.Time, resp, nil
+}
+
+// GetFailed returns webhook deliveries that failed and were not retried successfully.
+// Jira keeps failed deliveries for 72 hours.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-webhooks/#api-rest-api-3-webhook-failed-get
+func (s *WebhookService) GetFailed(ctx context.Context, options *FailedWebhookOptions) (*FailedWebhooks, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/3/webhook/failed", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(FailedWebhooks)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+
+	jira "github.com/conductorone/go-jira/v2/cloud"
+	"github.com/conductorone/go-jira/v2/cloud/connect"
+)
+
+func sign(secret []byte, body string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestHMACVerifier(t *testing.T) {
+	secret := []byte("It's a Secret to Everybody")
+	body := `{"webhookEvent":"jira:issue_created"}`
+	v := &HMACVerifier{Secret: secret}
+
+	tests := []struct {
+		header string
+		valid  bool
+	}{
+		{sign(secret, body), true},
+		{sign([]byte("wrong"), body), false},
+		{"", false},
+		{"sha256", false},
+		{"md5=abcdef", false},
+		{"sha256=not-hex", false},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/webhook", nil)
+		if tt.header != "" {
+			r.Header.Set("X-Hub-Signature", tt.header)
+		}
+		err := v.Verify(r, []byte(body))
+		if tt.valid && err != nil {
+			t.Errorf("Error given for %q: %s", tt.header, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrVerification) {
+			t.Errorf("Expected ErrVerification for %q. Got %v", tt.header, err)
+		}
+	}
+}
+
+func connectToken(t *testing.T, secret []byte, iss, method, target string, exp time.Time) string {
+	u, _ := url.Parse(target)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": iss,
+		"iat": time.Now().Unix(),
+		"exp": exp.Unix(),
+		"qsh": jira.QueryStringHash(method, u),
+	})
+	s, err := token.SignedString(secret)
+	if err != nil {
+		t.Fatalf("Error signing token: %s", err)
+	}
+	return s
+}
+
+func TestConnectJWTVerifier(t *testing.T) {
+	secret := []byte("shared-secret")
+	store := connect.NewMemoryTenantStore()
+	store.Save(context.Background(), &connect.Tenant{ClientKey: "tenant-1", SharedSecret: string(secret)})
+	v := &ConnectJWTVerifier{Verifier: &connect.Verifier{Store: store, BaseURL: "https://app.example.com/jira"}}
+	inAnHour := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"valid", connectToken(t, secret, "tenant-1", http.MethodPost, "/webhook?issue=1", inAnHour), true},
+		{"wrong secret", connectToken(t, []byte("wrong"), "tenant-1", http.MethodPost, "/webhook?issue=1", inAnHour), false},
+		{"unknown tenant", connectToken(t, secret, "tenant-2", http.MethodPost, "/webhook?issue=1", inAnHour), false},
+		{"expired", connectToken(t, secret, "tenant-1", http.MethodPost, "/webhook?issue=1", time.Now().Add(-time.Hour)), false},
+		{"wrong qsh", connectToken(t, secret, "tenant-1", http.MethodPost, "/other", inAnHour), false},
+		{"missing", "", false},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, "https://app.example.com/jira/webhook?issue=1", nil)
+		if tt.token != "" {
+			r.Header.Set("Authorization", "JWT "+tt.token)
+		}
+		err := v.Verify(r, nil)
+		if tt.valid && err != nil {
+			t.Errorf("%s: Error given: %s", tt.name, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrVerification) {
+			t.Errorf("%s: Expected ErrVerification. Got %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	defaultWebhookRefreshInterval = 24 * time.Hour
+	defaultWebhookRefreshBefore   = 7 * 24 * time.Hour
+
+	// webhookRefreshBatchSize is the number of webhook IDs refreshed per request.
+	webhookRefreshBatchSize = 100
+)
+
+// WebhookRefresher keeps dynamically registered webhooks alive.
+// Jira removes dynamic webhooks 30 days after their registration or last refresh.
+// The refresher periodically refreshes all webhooks of the app that expire soon
+// and reports webhook deliveries that failed since its last run.
+//
+// A WebhookRefresher must not be copied after first use.
+type WebhookRefresher struct {
+	Service WebhookAPI
+
+	// Interval is the time between two runs.
+	// It defaults to 24 hours.
+	Interval time.Duration
+
+	// RefreshBefore is how long before their expiration webhooks are refreshed.
+	// It defaults to 7 days.
+	RefreshBefore time.Duration
+
+	// OnFailed is called with the webhook deliveries that failed since the last run.
+	OnFailed func(ctx context.Context, failed []FailedWebhook)
+
+	// OnError is called if a run fails. The refresher keeps running.
+	OnError func(err error)
+
+	// lastFailure is the failure time (in milliseconds since the epoch) of the latest reported failed delivery
+	lastFailure int64
+}
+
+// Run refreshes the webhooks immediately and then every Interval until ctx is done.
+// It always returns a non-nil error, the error of ctx.
+func (r *WebhookRefresher) Run(ctx context.Context) error {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = defaultWebhookRefreshInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.RunOnce(ctx); err != nil && r.OnError != nil && ctx.Err() == nil {
+			r.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce refreshes all webhooks expiring within RefreshBefore
+// and reports failed deliveries to OnFailed.
+func (r *WebhookRefresher) RunOnce(ctx context.Context) error {
+	return errors.Join(r.refresh(ctx), r.reportFailed(ctx))
+}
+
+func (r *WebhookRefresher) refresh(ctx context.Context) error {
+	refreshBefore := r.RefreshBefore
+	if refreshBefore <= 0 {
+		refreshBefore = defaultWebhookRefreshBefore
+	}
+	deadline := time.Now().Add(refreshBefore)
+
+	var ids []int
+	options := &WebhookListOptions{}
+	for {
+		webhooks, resp, err := r.Service.GetAll(ctx, options)
+		if err != nil {
+			return err
+		}
+		for _, w := range webhooks {
+			if w.ExpirationDate == nil || w.ExpirationDate.Before(deadline) {
+				ids = append(ids, w.ID)
+			}
+		}
+
+		options.StartAt += len(webhooks)
+		if len(webhooks) == 0 || options.StartAt >= resp.Total {
+			break
+		}
+	}
+
+	for len(ids) > 0 {
+		n := min(len(ids), webhookRefreshBatchSize)
+		if _, _, err := r.Service.Refresh(ctx, ids[:n]...); err != nil {
+			return err
+		}
+		ids = ids[n:]
+	}
+	return nil
+}
+
+func (r *WebhookRefresher) reportFailed(ctx context.Context) error {
+	if r.OnFailed == nil {
+		return nil
+	}
+
+	var failed []FailedWebhook
+	after := r.lastFailure
+	for {
+		page, _, err := r.Service.GetFailed(ctx, &FailedWebhookOptions{After: after})
+		if err != nil {
+			return err
+		}
+		previous := after
+		for _, f := range page.Values {
+			ms := f.FailureTime.UnixMilli()
+			if ms <= previous {
+				continue
+			}
+			failed = append(failed, f)
+			after = max(after, ms)
+		}
+		// Stop on the last page or if the page did not contain newer failures
+		if page.Next == "" || after == previous {
+			break
+		}
+	}
+
+	if len(failed) > 0 {
+		r.lastFailure = after
+		r.OnFailed(ctx, failed)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWebhookService_Register(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/webhook", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var body webhookRegistration
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Error decoding body: %s", err)
+		}
+		if body.URL != "https://app.example.com/webhook" || len(body.Webhooks) != 2 {
+			t.Errorf("Unexpected body: %+v", body)
+		}
+		if body.Webhooks[0].JQLFilter != "project = TEST" || !reflect.DeepEqual(body.Webhooks[0].Events, []string{"jira:issue_created"}) {
+			t.Errorf("Unexpected webhook: %+v", body.Webhooks[0])
+		}
+
+		fmt.Fprint(w, `{"webhookRegistrationResult":[{"createdWebhookId":1000},{"errors":["The clause watchCount is unsupported"]}]}`)
+	})
+
+	results, _, err := testClient.Webhook.Register(context.Background(), "https://app.example.com/webhook", []WebhookDetails{
+		{JQLFilter: "project = TEST", Events: []string{"jira:issue_created"}},
+		{JQLFilter: "watchCount > 1", Events: []string{"jira:issue_updated"}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	want := []WebhookRegistrationResult{
+		{CreatedWebhookID: 1000},
+		{Errors: []string{"The clause watchCount is unsupported"}},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("Results: %+v, want %+v", results, want)
+	}
+}
+
+func TestWebhookService_GetAll(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/webhook", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"startAt": "2", "maxResults": "2"})
+
+		fmt.Fprint(w, `{"isLast":true,"maxResults":2,"startAt":2,"total":3,"values":[{"id":10002,"jqlFilter":"project = TEST","events":["jira:issue_updated"],"expirationDate":"2019-06-01T12:42:30.000+0000"}]}`)
+	})
+
+	webhooks, resp, err := testClient.Webhook.GetAll(context.Background(), &WebhookListOptions{StartAt: 2, MaxResults: 2})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(webhooks) != 1 || webhooks[0].ID != 10002 {
+		t.Errorf("Unexpected webhooks: %+v", webhooks)
+	}
+	if want := time.Date(2019, 6, 1, 12, 42, 30, 0, time.UTC); !webhooks[0].ExpirationDate.Equal(want) {
+		t.Errorf("ExpirationDate: %v, want %v", webhooks[0].ExpirationDate, want)
+	}
+	if resp.StartAt != 2 || resp.Total != 3 {
+		t.Errorf("Unexpected paging: startAt %d, total %d", resp.StartAt, resp.Total)
+	}
+}
+
+func TestWebhookService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/webhook", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+
+		var body webhookIDs
+		json.NewDecoder(r.Body).Decode(&body)
+		if !reflect.DeepEqual(body.WebhookIDs, []int{1, 2}) {
+			t.Errorf("WebhookIDs: %v", body.WebhookIDs)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if _, err := testClient.Webhook.Delete(context.Background(), 1, 2); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestWebhookService_Refresh(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/webhook/refresh", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"expirationDate":1559392950000}`)
+	})
+
+	expiration, _, err := testClient.Webhook.Refresh(context.Background(), 1000)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if expiration.UnixMilli() != 1559392950000 {
+		t.Errorf("Expiration: %v", expiration)
+	}
+}
+
+func TestWebhookService_GetFailed(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/webhook/failed", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"after": "1573118132000"})
+		fmt.Fprint(w, `{"values":[{"id":"1","body":"{\"data\":1}","url":"https://app.example.com/webhook","failureTime":1573540473480}],"maxResults":100}`)
+	})
+
+	failed, _, err := testClient.Webhook.GetFailed(context.Background(), &FailedWebhookOptions{After: 1573118132000})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(failed.Values) != 1 || failed.Values[0].FailureTime.UnixMilli() != 1573540473480 || failed.Next != "" {
+		t.Errorf("Unexpected failed webhooks: %+v", failed)
+	}
+}
+
+func TestWebhookRefresher_RunOnce(t *testing.T) {
+	setup()
+	defer teardown()
+
+	soon := time.Now().Add(24 * time.Hour).UnixMilli()
+	later := time.Now().Add(25 * 24 * time.Hour).UnixMilli()
+	testMux.HandleFunc("/rest/api/3/webhook", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("startAt") == "" {
+			fmt.Fprintf(w, `{"isLast":false,"startAt":0,"total":3,"values":[{"id":1,"expirationDate":%d},{"id":2,"expirationDate":%d}]}`, soon, later)
+			return
+		}
+		fmt.Fprintf(w, `{"isLast":true,"startAt":2,"total":3,"values":[{"id":3,"expirationDate":%d}]}`, soon)
+	})
+
+	var refreshed []int
+	testMux.HandleFunc("/rest/api/3/webhook/refresh", func(w http.ResponseWriter, r *http.Request) {
+		var body webhookIDs
+		json.NewDecoder(r.Body).Decode(&body)
+		refreshed = append(refreshed, body.WebhookIDs...)
+		fmt.Fprintf(w, `{"expirationDate":%d}`, later)
+	})
+
+	var afters []string
+	testMux.HandleFunc("/rest/api/3/webhook/failed", func(w http.ResponseWriter, r *http.Request) {
+		afters = append(afters, r.URL.Query().Get("after"))
+		if r.URL.Query().Get("after") == "" {
+			fmt.Fprint(w, `{"values":[{"id":"1","url":"https://app.example.com/webhook","failureTime":1000},{"id":"2","url":"https://app.example.com/webhook","failureTime":2000}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"values":[]}`)
+	})
+
+	var reported []FailedWebhook
+	refresher := &WebhookRefresher{
+		Service: testClient.Webhook,
+		OnFailed: func(ctx context.Context, failed []FailedWebhook) {
+			reported = append(reported, failed...)
+		},
+	}
+
+	if err := refresher.RunOnce(context.Background()); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(refreshed, []int{1, 3}) {
+		t.Errorf("Refreshed: %v, want [1 3]", refreshed)
+	}
+	if len(reported) != 2 {
+		t.Errorf("Expected 2 failed deliveries. Got %d", len(reported))
+	}
+
+	// The second run only asks for failures after the latest reported one
+	if err := refresher.RunOnce(context.Background()); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(afters, []string{"", "2000"}) {
+		t.Errorf("after parameters: %v", afters)
+	}
+	if len(reported) != 2 {
+		t.Errorf("Expected no new failed deliveries. Got %d", len(reported)-2)
+	}
+}
+
+func TestWebhookRefresher_Run(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/webhook", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var errs int
+	refresher := &WebhookRefresher{
+		Service:  testClient.Webhook,
+		Interval: time.Millisecond,
+		OnError: func(err error) {
+			errs++
+			if errs == 2 {
+				cancel()
+			}
+		},
+	}
+
+	if err := refresher.Run(ctx); err != context.Canceled {
+		t.Errorf("Expected context.Canceled. Got %v", err)
+	}
+	if errs != 2 {
+		t.Errorf("Expected 2 errors. Got %d", errs)
+	}
+}
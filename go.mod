@@ -8,4 +8,5 @@ require (
 	github.com/google/go-cmp v0.7.0
 	github.com/google/go-querystring v1.1.0
 	github.com/trivago/tgo v1.0.7
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,442 @@
+// Command servicegen generates the interfaces of the services of a package and mocks implementing them.
+//
+// It is run by go generate in the cloud and onpremise packages:
+//
+//	//go:generate go run ../internal/servicegen
+//
+// For every exported type named like "IssueService", it writes the interface "IssueAPI" with all exported methods
+// of the service to services.go and the mock "IssueService" to mocks/mocks.go.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	servicesFile = "services.go"
+	mocksFile    = "mocks/mocks.go"
+	header       = "// Code generated by servicegen. DO NOT EDIT.\n\n"
+)
+
+// method is an exported method of a service.
+type method struct {
+	name    string
+	doc     string
+	params  []param
+	results []param
+}
+
+// param is a parameter or result of a method.
+type param struct {
+	name     string
+	typ      string // unqualified
+	mockTyp  string // qualified with the package name
+	variadic bool
+}
+
+type generator struct {
+	pkg        string
+	importPath string
+
+	services map[string][]*method
+	imports  map[string]string // package name -> import path of the packages used in signatures
+	used     map[string]bool
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("servicegen: ")
+
+	importPath, err := packageImportPath()
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := &generator{
+		importPath: importPath,
+		services:   make(map[string][]*method),
+		imports:    make(map[string]string),
+		used:       make(map[string]bool),
+	}
+	if err := g.parse("."); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := write(servicesFile, g.interfaces()); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(mocksFile), 0o755); err != nil {
+		log.Fatal(err)
+	}
+	if err := write(mocksFile, g.mocks()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// packageImportPath returns the import path of the package in the working directory from the enclosing go.mod.
+func packageImportPath() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for rel := ""; ; {
+		f, err := os.Open(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			defer f.Close()
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				if module, ok := strings.CutPrefix(scanner.Text(), "module "); ok {
+					return strings.TrimSuffix(strings.TrimSpace(module)+"/"+rel, "/"), nil
+				}
+			}
+			return "", fmt.Errorf("no module directive in %s", f.Name())
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("no go.mod found")
+		}
+		rel = strings.TrimSuffix(filepath.Base(dir)+"/"+rel, "/")
+		dir = parent
+	}
+}
+
+func (g *generator) parse(dir string) error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != servicesFile
+	}, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	if len(pkgs) != 1 {
+		return fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+
+	for name, pkg := range pkgs {
+		g.pkg = name
+		// Find the services first, the methods may be declared in other files
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					name := spec.(*ast.TypeSpec).Name.Name
+					if ast.IsExported(name) && strings.HasSuffix(name, "Service") {
+						g.services[name] = nil
+					}
+				}
+			}
+		}
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+					continue
+				}
+				star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+				if !ok {
+					continue
+				}
+				recv, ok := star.X.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				if _, ok := g.services[recv.Name]; !ok {
+					continue
+				}
+				g.services[recv.Name] = append(g.services[recv.Name], g.method(file, fn))
+			}
+		}
+	}
+
+	for name, methods := range g.services {
+		if len(methods) == 0 {
+			delete(g.services, name)
+			continue
+		}
+		sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+	}
+	return nil
+}
+
+func (g *generator) method(file *ast.File, fn *ast.FuncDecl) *method {
+	m := &method{name: fn.Name.Name}
+	if fn.Doc != nil {
+		m.doc, _, _ = strings.Cut(fn.Doc.Text(), "\n")
+	}
+	for _, field := range fn.Type.Params.List {
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: "_"}}
+		}
+		for _, name := range names {
+			p := param{
+				name:    name.Name,
+				typ:     g.typeString(file, field.Type, false),
+				mockTyp: g.typeString(file, field.Type, true),
+			}
+			_, p.variadic = field.Type.(*ast.Ellipsis)
+			m.params = append(m.params, p)
+		}
+	}
+	if fn.Type.Results != nil {
+		for _, field := range fn.Type.Results.List {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				m.results = append(m.results, param{
+					typ:     g.typeString(file, field.Type, false),
+					mockTyp: g.typeString(file, field.Type, true),
+				})
+			}
+		}
+	}
+	// Unnamed parameters get a name, so the mocks can pass them on
+	for i := range m.params {
+		if m.params[i].name == "_" {
+			m.params[i].name = "p" + strconv.Itoa(i)
+		}
+	}
+	return m
+}
+
+// typeString returns the source of a type expression. If qualify is set, the types of the package
+// are qualified with its name.
+func (g *generator) typeString(file *ast.File, expr ast.Expr, qualify bool) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if qualify && t.IsExported() {
+			return g.pkg + "." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + g.typeString(file, t.X, qualify)
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		g.useImport(file, pkg)
+		return pkg + "." + t.Sel.Name
+	case *ast.ArrayType:
+		length := ""
+		if t.Len != nil {
+			length = t.Len.(*ast.BasicLit).Value
+		}
+		return "[" + length + "]" + g.typeString(file, t.Elt, qualify)
+	case *ast.MapType:
+		return "map[" + g.typeString(file, t.Key, qualify) + "]" + g.typeString(file, t.Value, qualify)
+	case *ast.Ellipsis:
+		return "..." + g.typeString(file, t.Elt, qualify)
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "interface{}"
+		}
+	case *ast.ChanType:
+		return "chan " + g.typeString(file, t.Value, qualify)
+	case *ast.FuncType:
+		var params, results []string
+		for _, field := range t.Params.List {
+			for range max(len(field.Names), 1) {
+				params = append(params, g.typeString(file, field.Type, qualify))
+			}
+		}
+		if t.Results != nil {
+			for _, field := range t.Results.List {
+				for range max(len(field.Names), 1) {
+					results = append(results, g.typeString(file, field.Type, qualify))
+				}
+			}
+		}
+		return "func(" + strings.Join(params, ", ") + ")" + resultList(results)
+	}
+	log.Fatalf("unsupported type %T", expr)
+	return ""
+}
+
+// useImport records the import of the package with the given name in file.
+func (g *generator) useImport(file *ast.File, name string) {
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		local := filepath.Base(path)
+		if spec.Name != nil {
+			local = spec.Name.Name
+		}
+		if local == name {
+			g.imports[name] = path
+			g.used[name] = true
+			return
+		}
+	}
+	log.Fatalf("unknown package %s in %s", name, file.Name.Name)
+}
+
+func (g *generator) serviceNames() []string {
+	names := make([]string, 0, len(g.services))
+	for name := range g.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (g *generator) importBlock(extra ...string) string {
+	var std, other []string
+	for name := range g.used {
+		path := g.imports[name]
+		if first, _, _ := strings.Cut(path, "/"); strings.Contains(first, ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	other = append(other, extra...)
+	sort.Strings(std)
+	sort.Strings(other)
+
+	var b strings.Builder
+	b.WriteString("import (\n")
+	for _, path := range std {
+		fmt.Fprintf(&b, "\t%q\n", path)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		b.WriteString("\n")
+	}
+	for _, path := range other {
+		fmt.Fprintf(&b, "\t%q\n", path)
+	}
+	b.WriteString(")\n\n")
+	return b.String()
+}
+
+// interfaceName returns the name of the interface of a service, like "IssueAPI" for "IssueService".
+func interfaceName(service string) string {
+	return strings.TrimSuffix(service, "Service") + "API"
+}
+
+func (g *generator) interfaces() []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", g.pkg)
+	b.WriteString(g.importBlock())
+
+	for _, service := range g.serviceNames() {
+		fmt.Fprintf(&b, "// %s is the interface of the %s, so it can be replaced in tests.\n", interfaceName(service), service)
+		fmt.Fprintf(&b, "// See the %s for the documentation of the methods.\n", service)
+		fmt.Fprintf(&b, "type %s interface {\n", interfaceName(service))
+		for i, m := range g.services[service] {
+			if m.doc != "" {
+				if i > 0 {
+					b.WriteString("\n")
+				}
+				fmt.Fprintf(&b, "\t// %s\n", m.doc)
+			}
+			params := make([]string, len(m.params))
+			for i, p := range m.params {
+				params[i] = p.name + " " + p.typ
+			}
+			results := make([]string, len(m.results))
+			for i, r := range m.results {
+				results[i] = r.typ
+			}
+			fmt.Fprintf(&b, "\t%s(%s)%s\n", m.name, strings.Join(params, ", "), resultList(results))
+		}
+		b.WriteString("}\n\n")
+	}
+
+	b.WriteString("// Compile-time checks that the services implement their interfaces.\nvar (\n")
+	for _, service := range g.serviceNames() {
+		fmt.Fprintf(&b, "\t_ %s = (*%s)(nil)\n", interfaceName(service), service)
+	}
+	b.WriteString(")\n")
+	return b.Bytes()
+}
+
+func (g *generator) mocks() []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "// Package mocks provides mocks of the services of the %s package.\n", g.pkg)
+	b.WriteString("//\n// A mock calls the function of the field named like the method with the suffix \"Func\".\n")
+	b.WriteString("// Calling a method without a function panics.\n")
+	b.WriteString("package mocks\n\n")
+	b.WriteString(g.importBlock(g.importPath))
+
+	for _, service := range g.serviceNames() {
+		iface := g.pkg + "." + interfaceName(service)
+		fmt.Fprintf(&b, "// %s is a mock of %s.\n", service, iface)
+		fmt.Fprintf(&b, "type %s struct {\n", service)
+		for _, m := range g.services[service] {
+			types := make([]string, len(m.params))
+			for i, p := range m.params {
+				types[i] = p.mockTyp
+			}
+			fmt.Fprintf(&b, "\t%sFunc func(%s)%s\n", m.name, strings.Join(types, ", "), resultList(mockResults(m)))
+		}
+		b.WriteString("}\n\n")
+
+		for _, m := range g.services[service] {
+			params := make([]string, len(m.params))
+			args := make([]string, len(m.params))
+			for i, p := range m.params {
+				params[i] = p.name + " " + p.mockTyp
+				args[i] = p.name
+				if p.variadic {
+					args[i] += "..."
+				}
+			}
+			fmt.Fprintf(&b, "// %s calls %sFunc.\n", m.name, m.name)
+			fmt.Fprintf(&b, "func (mock *%s) %s(%s)%s {\n", service, m.name, strings.Join(params, ", "), resultList(mockResults(m)))
+			fmt.Fprintf(&b, "\tif mock.%sFunc == nil {\n\t\tpanic(\"mocks: %s.%s is not implemented\")\n\t}\n", m.name, service, m.name)
+			call := fmt.Sprintf("mock.%sFunc(%s)", m.name, strings.Join(args, ", "))
+			if len(m.results) > 0 {
+				call = "return " + call
+			}
+			fmt.Fprintf(&b, "\t%s\n}\n\n", call)
+		}
+	}
+
+	b.WriteString("// Compile-time checks that the mocks implement the interfaces.\nvar (\n")
+	for _, service := range g.serviceNames() {
+		fmt.Fprintf(&b, "\t_ %s.%s = (*%s)(nil)\n", g.pkg, interfaceName(service), service)
+	}
+	b.WriteString(")\n")
+	return b.Bytes()
+}
+
+func mockResults(m *method) []string {
+	results := make([]string, len(m.results))
+	for i, r := range m.results {
+		results[i] = r.mockTyp
+	}
+	return results
+}
+
+func resultList(results []string) string {
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return " " + results[0]
+	}
+	return " (" + strings.Join(results, ", ") + ")"
+}
+
+func write(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w\n%s", path, err, src)
+	}
+	return os.WriteFile(path, formatted, 0o644)
+}
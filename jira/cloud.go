@@ -0,0 +1,392 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/conductorone/go-jira/v2/cloud"
+	"github.com/trivago/tgo/tcontainer"
+)
+
+type cloudClient struct {
+	capabilities
+	client *cloud.Client
+}
+
+// NewCloud returns a Client backed by a Jira Cloud client.
+//
+// Users are identified by their account ID.
+// Groups are addressed by their ID if set, and by their name otherwise.
+func NewCloud(client *cloud.Client) Client {
+	return &cloudClient{
+		capabilities: capabilities{
+			deployment:  Cloud,
+			unsupported: []Capability{CapabilityUsernames, CapabilityPersonalAccessTokens},
+		},
+		client: client,
+	}
+}
+
+func cloudStatusCode(resp *cloud.Response) int {
+	if resp == nil || resp.Response == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
+
+func fromCloudUser(u *cloud.User) *User {
+	if u == nil {
+		return nil
+	}
+	return &User{
+		ID:          u.AccountID,
+		AccountID:   u.AccountID,
+		Name:        u.Name,
+		Key:         u.Key,
+		Email:       u.EmailAddress,
+		DisplayName: u.DisplayName,
+		Active:      u.Active,
+	}
+}
+
+func fromCloudIssue(i *cloud.Issue) *Issue {
+	issue := &Issue{ID: i.ID, Key: i.Key}
+	f := i.Fields
+	if f == nil {
+		return issue
+	}
+	issue.ProjectKey = f.Project.Key
+	issue.Type = f.Type.Name
+	issue.Summary = f.Summary
+	issue.Description = f.Description
+	if f.Status != nil {
+		issue.Status = f.Status.Name
+	}
+	issue.Assignee = fromCloudUser(f.Assignee)
+	issue.Reporter = fromCloudUser(f.Reporter)
+	issue.Created = time.Time(f.Created)
+	issue.Updated = time.Time(f.Updated)
+	if len(f.Unknowns) > 0 {
+		issue.Fields = make(map[string]interface{}, len(f.Unknowns))
+		for k, v := range f.Unknowns {
+			issue.Fields[k] = v
+		}
+	}
+	return issue
+}
+
+func fromCloudActor(a *cloud.Actor) RoleActor {
+	if a.ActorGroup != nil {
+		return RoleActor{Group: &Group{ID: a.ActorGroup.GroupID, Name: a.ActorGroup.Name}}
+	}
+	user := &User{Name: a.Name, DisplayName: a.DisplayName}
+	if a.ActorUser != nil {
+		user.ID = a.ActorUser.AccountID
+		user.AccountID = a.ActorUser.AccountID
+	}
+	return RoleActor{User: user}
+}
+
+func (c *cloudClient) CurrentUser(ctx context.Context) (*User, error) {
+	user, resp, err := c.client.User.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	return fromCloudUser(user), nil
+}
+
+func (c *cloudClient) User(ctx context.Context, id string) (*User, error) {
+	user, resp, err := c.client.User.GetByAccountID(ctx, id)
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	return fromCloudUser(user), nil
+}
+
+func (c *cloudClient) FindUsers(ctx context.Context, query string) ([]User, error) {
+	users, resp, err := c.client.User.Find(ctx, query)
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	result := make([]User, 0, len(users))
+	for i := range users {
+		result = append(result, *fromCloudUser(&users[i]))
+	}
+	return result, nil
+}
+
+func (c *cloudClient) UserGroups(ctx context.Context, id string) ([]Group, error) {
+	groups, resp, err := c.client.User.GetGroups(ctx, id)
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	result := make([]Group, 0, len(*groups))
+	for _, g := range *groups {
+		result = append(result, Group{Name: g.Name})
+	}
+	return result, nil
+}
+
+func (c *cloudClient) Issue(ctx context.Context, idOrKey string) (*Issue, error) {
+	issue, resp, err := c.client.Issue.Get(ctx, idOrKey, nil)
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	return fromCloudIssue(issue), nil
+}
+
+func (c *cloudClient) SearchIssues(ctx context.Context, jql string, options *SearchOptions) ([]Issue, error) {
+	var searchOptions *cloud.SearchOptions
+	if options != nil {
+		searchOptions = &cloud.SearchOptions{StartAt: options.StartAt, MaxResults: options.MaxResults}
+	}
+	issues, resp, err := c.client.Issue.Search(ctx, jql, searchOptions)
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	result := make([]Issue, 0, len(issues))
+	for i := range issues {
+		result = append(result, *fromCloudIssue(&issues[i]))
+	}
+	return result, nil
+}
+
+func (c *cloudClient) CreateIssue(ctx context.Context, issue *Issue) (*Issue, error) {
+	fields := &cloud.IssueFields{
+		Project:     cloud.Project{Key: issue.ProjectKey},
+		Type:        cloud.IssueType{Name: issue.Type},
+		Summary:     issue.Summary,
+		Description: issue.Description,
+	}
+	if issue.Assignee != nil {
+		fields.Assignee = &cloud.User{AccountID: issue.Assignee.ID}
+	}
+	if issue.Reporter != nil {
+		fields.Reporter = &cloud.User{AccountID: issue.Reporter.ID}
+	}
+	if len(issue.Fields) > 0 {
+		fields.Unknowns = tcontainer.MarshalMap{}
+		for k, v := range issue.Fields {
+			fields.Unknowns[k] = v
+		}
+	}
+	created, resp, err := c.client.Issue.Create(ctx, &cloud.Issue{Fields: fields})
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	result := *issue
+	result.ID, result.Key = created.ID, created.Key
+	return &result, nil
+}
+
+func (c *cloudClient) AddComment(ctx context.Context, idOrKey, body string) error {
+	_, resp, err := c.client.Issue.AddComment(ctx, idOrKey, &cloud.Comment{Body: body})
+	return wrapError(cloudStatusCode(resp), err)
+}
+
+func (c *cloudClient) FindGroups(ctx context.Context, query string) ([]Group, error) {
+	groups, resp, err := c.client.Group.Find(ctx, cloud.WithGroupNameContains(query))
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	result := make([]Group, 0, len(groups))
+	for _, g := range groups {
+		result = append(result, Group{ID: g.ID, Name: g.Name})
+	}
+	return result, nil
+}
+
+func (c *cloudClient) GroupMembers(ctx context.Context, group Group) ([]User, error) {
+	var result []User
+	for startAt := 0; ; startAt += groupPageSize {
+		var (
+			members []cloud.GroupMember
+			resp    *cloud.Response
+			err     error
+		)
+		if group.ID != "" {
+			members, resp, err = c.client.Group.GetGroupMembers(ctx, group.ID, cloud.WithStartAt(startAt), cloud.WithMaxResults(groupPageSize))
+		} else {
+			members, resp, err = c.client.Group.Get(ctx, group.Name, &cloud.GroupSearchOptions{StartAt: startAt, MaxResults: groupPageSize})
+		}
+		if err != nil {
+			return nil, wrapError(cloudStatusCode(resp), err)
+		}
+		for _, m := range members {
+			result = append(result, User{
+				ID:          m.AccountID,
+				AccountID:   m.AccountID,
+				Name:        m.Name,
+				Key:         m.Key,
+				Email:       m.EmailAddress,
+				DisplayName: m.DisplayName,
+				Active:      m.Active,
+			})
+		}
+		if len(members) < groupPageSize {
+			return result, nil
+		}
+	}
+}
+
+func (c *cloudClient) AddGroupMember(ctx context.Context, group Group, userID string) error {
+	var (
+		resp *cloud.Response
+		err  error
+	)
+	if group.ID != "" {
+		resp, err = c.client.Group.AddUserByGroupId(ctx, group.ID, userID)
+	} else {
+		_, resp, err = c.client.Group.AddUserByGroupName(ctx, group.Name, userID)
+	}
+	return wrapError(cloudStatusCode(resp), err)
+}
+
+func (c *cloudClient) RemoveGroupMember(ctx context.Context, group Group, userID string) error {
+	var (
+		resp *cloud.Response
+		err  error
+	)
+	if group.ID != "" {
+		resp, err = c.client.Group.RemoveUserByGroupId(ctx, group.ID, userID)
+	} else {
+		resp, err = c.client.Group.RemoveUserByGroupName(ctx, group.Name, userID)
+	}
+	return wrapError(cloudStatusCode(resp), err)
+}
+
+func (c *cloudClient) Project(ctx context.Context, idOrKey string) (*Project, error) {
+	project, resp, err := c.client.Project.Get(ctx, idOrKey)
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	result := &Project{
+		ID:          project.ID,
+		Key:         project.Key,
+		Name:        project.Name,
+		Description: project.Description,
+	}
+	if project.Lead.AccountID != "" || project.Lead.Name != "" {
+		result.Lead = fromCloudUser(&project.Lead)
+	}
+	return result, nil
+}
+
+func (c *cloudClient) Projects(ctx context.Context) ([]Project, error) {
+	projects, resp, err := c.client.Project.GetAll(ctx, nil)
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	result := make([]Project, 0, len(*projects))
+	for _, p := range *projects {
+		result = append(result, Project{ID: p.ID, Key: p.Key, Name: p.Name})
+	}
+	return result, nil
+}
+
+func (c *cloudClient) Roles(ctx context.Context) ([]Role, error) {
+	roles, resp, err := c.client.Role.GetList(ctx)
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	result := make([]Role, 0, len(*roles))
+	for _, r := range *roles {
+		result = append(result, Role{ID: r.ID, Name: r.Name, Description: r.Description})
+	}
+	return result, nil
+}
+
+func (c *cloudClient) ProjectRole(ctx context.Context, projectIDOrKey string, roleID int) (*Role, error) {
+	role, resp, err := c.client.Role.Get(ctx, roleID)
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	actors, resp, err := c.client.Role.GetRoleActorsForProject(ctx, projectIDOrKey, roleID)
+	if err != nil {
+		return nil, wrapError(cloudStatusCode(resp), err)
+	}
+	result := &Role{ID: role.ID, Name: role.Name, Description: role.Description}
+	for _, a := range actors {
+		result.Actors = append(result.Actors, fromCloudActor(a))
+	}
+	return result, nil
+}
+
+func (c *cloudClient) AddProjectRoleActor(ctx context.Context, projectIDOrKey string, roleID int, actor RoleActor) error {
+	var (
+		resp *cloud.Response
+		err  error
+	)
+	switch {
+	case actor.User != nil && actor.Group == nil:
+		resp, err = c.client.Role.AddUserToRole(ctx, projectIDOrKey, roleID, actor.User.ID)
+	case actor.Group != nil && actor.User == nil:
+		id, err := c.groupID(ctx, *actor.Group)
+		if err != nil {
+			return err
+		}
+		_, resp, err = c.client.Role.AddGroupToRole(ctx, projectIDOrKey, roleID, id)
+		return wrapError(cloudStatusCode(resp), err)
+	default:
+		return errInvalidActor
+	}
+	return wrapError(cloudStatusCode(resp), err)
+}
+
+func (c *cloudClient) RemoveProjectRoleActor(ctx context.Context, projectIDOrKey string, roleID int, actor RoleActor) error {
+	var (
+		resp *cloud.Response
+		err  error
+	)
+	switch {
+	case actor.User != nil && actor.Group == nil:
+		resp, err = c.client.Role.RemoveUserFromRole(ctx, projectIDOrKey, roleID, actor.User.ID)
+	case actor.Group != nil && actor.User == nil:
+		// The group is removed by its name, which Jira still accepts
+		name, err := c.groupName(ctx, *actor.Group)
+		if err != nil {
+			return err
+		}
+		resp, err = c.client.Role.RemoveGroupFromRole(ctx, projectIDOrKey, roleID, name)
+		return wrapError(cloudStatusCode(resp), err)
+	default:
+		return errInvalidActor
+	}
+	return wrapError(cloudStatusCode(resp), err)
+}
+
+// groupName returns the name of the group, looking it up by ID if it is not set.
+func (c *cloudClient) groupName(ctx context.Context, group Group) (string, error) {
+	if group.Name != "" {
+		return group.Name, nil
+	}
+	groups, resp, err := c.client.Group.Bulk(ctx, cloud.WithGroupIDs([]string{group.ID}))
+	if err != nil {
+		return "", wrapError(cloudStatusCode(resp), err)
+	}
+	for _, g := range groups {
+		if g.ID == group.ID {
+			return g.Name, nil
+		}
+	}
+	return "", fmt.Errorf("%w: group %q", ErrNotFound, group.ID)
+}
+
+// groupID returns the ID of the group, looking it up by name if it is not set.
+func (c *cloudClient) groupID(ctx context.Context, group Group) (string, error) {
+	if group.ID != "" {
+		return group.ID, nil
+	}
+	groups, err := c.FindGroups(ctx, group.Name)
+	if err != nil {
+		return "", err
+	}
+	for _, g := range groups {
+		if strings.EqualFold(g.Name, group.Name) {
+			return g.ID, nil
+		}
+	}
+	return "", fmt.Errorf("%w: group %q", ErrNotFound, group.Name)
+}
@@ -0,0 +1,96 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/conductorone/go-jira/v2/cloud"
+	"github.com/conductorone/go-jira/v2/onpremise"
+)
+
+// personalAccessTokensVersion is the first Jira Server / Data Center version with personal access tokens.
+var personalAccessTokensVersion = []int{8, 14}
+
+// ServerInfo is the version and deployment of a Jira instance.
+type ServerInfo struct {
+	Deployment Deployment
+	// DeploymentType is the deployment type as reported by Jira: "Cloud", "Server" or "DataCenter".
+	DeploymentType string
+	Version        string
+	VersionNumbers []int
+	BuildNumber    int
+	ServerTime     time.Time
+}
+
+// AtLeast reports whether the version is at least the given one, like AtLeast(8, 14).
+func (i ServerInfo) AtLeast(version ...int) bool {
+	for n, want := range version {
+		var got int
+		if n < len(i.VersionNumbers) {
+			got = i.VersionNumbers[n]
+		}
+		if got != want {
+			return got > want
+		}
+	}
+	return true
+}
+
+// Detected is a Client for a Jira instance whose deployment was detected by Detect.
+//
+// Its capabilities take the version of the instance into account.
+// Exactly one of Cloud and OnPremise is set, for the features not covered by Client.
+type Detected struct {
+	Client
+	Info      ServerInfo
+	Cloud     *cloud.Client
+	OnPremise *onpremise.Client
+}
+
+// Detect asks the Jira instance at baseURL for its server information
+// and returns a Client of the matching package.
+//
+// httpClient is used for all requests, including the detection, and should handle the authentication.
+// If it is nil, http.DefaultClient is used.
+func Detect(ctx context.Context, baseURL string, httpClient *http.Client) (*Detected, error) {
+	probe, err := cloud.NewClient(baseURL, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	info, _, err := probe.ServerInfo.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("jira: detecting the deployment of %s: %w", baseURL, err)
+	}
+
+	detected := &Detected{Info: ServerInfo{
+		DeploymentType: info.DeploymentType,
+		Version:        info.Version,
+		VersionNumbers: info.VersionNumbers,
+		BuildNumber:    info.BuildNumber,
+	}}
+	if info.ServerTime != nil {
+		detected.Info.ServerTime = time.Time(*info.ServerTime)
+	}
+
+	if info.DeploymentType == cloud.DeploymentTypeCloud {
+		detected.Info.Deployment = Cloud
+		detected.Cloud = probe
+		detected.Client = NewCloud(probe)
+		return detected, nil
+	}
+
+	client, err := onpremise.NewClient(baseURL, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	adapter := newOnPremiseClient(client)
+	if !detected.Info.AtLeast(personalAccessTokensVersion...) {
+		adapter.unsupported = append(adapter.unsupported, CapabilityPersonalAccessTokens)
+	}
+	detected.Info.Deployment = OnPremise
+	detected.OnPremise = client
+	detected.Client = adapter
+	return detected, nil
+}
@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"context"
+	"testing"
+
+	"github.com/conductorone/go-jira/v2/jiratest"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name            string
+		info            jiratest.ServerInfo
+		wantDeployment  Deployment
+		wantSupported   []Capability
+		wantUnsupported []Capability
+	}{
+		{
+			name:            "cloud",
+			info:            jiratest.ServerInfo{Version: "1001.0.0-SNAPSHOT", DeploymentType: "Cloud", BuildNumber: 100264},
+			wantDeployment:  Cloud,
+			wantSupported:   []Capability{CapabilityEnhancedSearch, CapabilityGroupIDs},
+			wantUnsupported: []Capability{CapabilityPersonalAccessTokens},
+		},
+		{
+			name:            "data center",
+			info:            jiratest.ServerInfo{Version: "9.12.2", DeploymentType: "DataCenter", BuildNumber: 9120002},
+			wantDeployment:  OnPremise,
+			wantSupported:   []Capability{CapabilityPersonalAccessTokens},
+			wantUnsupported: []Capability{CapabilityEnhancedSearch, CapabilityGroupIDs},
+		},
+		{
+			name:            "old server",
+			info:            jiratest.ServerInfo{Version: "8.5.1", DeploymentType: "Server", BuildNumber: 805001},
+			wantDeployment:  OnPremise,
+			wantUnsupported: []Capability{CapabilityPersonalAccessTokens, CapabilityEnhancedSearch},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := jiratest.NewServer()
+			defer server.Close()
+			server.SetServerInfo(tt.info)
+
+			detected, err := Detect(context.Background(), server.URL, nil)
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if detected.Deployment() != tt.wantDeployment || detected.Info.Deployment != tt.wantDeployment {
+				t.Errorf("Detect() deployment = %s, want %s", detected.Deployment(), tt.wantDeployment)
+			}
+			if (detected.Cloud != nil) != (tt.wantDeployment == Cloud) || (detected.OnPremise != nil) != (tt.wantDeployment == OnPremise) {
+				t.Errorf("Detect() Cloud = %v, OnPremise = %v", detected.Cloud, detected.OnPremise)
+			}
+			if detected.Info.Version != tt.info.Version || detected.Info.BuildNumber != tt.info.BuildNumber || detected.Info.ServerTime.IsZero() {
+				t.Errorf("Detect() info = %+v", detected.Info)
+			}
+			for _, c := range tt.wantSupported {
+				if !detected.Supports(c) {
+					t.Errorf("Supports(%s) = false", c)
+				}
+			}
+			for _, c := range tt.wantUnsupported {
+				if detected.Supports(c) {
+					t.Errorf("Supports(%s) = true", c)
+				}
+			}
+
+			// The detected client talks to the instance
+			if _, err := detected.Project(context.Background(), "EX"); err == nil {
+				t.Error("Project() of unknown project error = nil")
+			}
+		})
+	}
+}
+
+func TestServerInfo_AtLeast(t *testing.T) {
+	info := ServerInfo{VersionNumbers: []int{8, 14, 1}}
+	for _, tt := range []struct {
+		version []int
+		want    bool
+	}{
+		{[]int{8, 14}, true},
+		{[]int{8, 14, 1}, true},
+		{[]int{8, 14, 2}, false},
+		{[]int{8, 5}, true},
+		{[]int{9}, false},
+	} {
+		if got := info.AtLeast(tt.version...); got != tt.want {
+			t.Errorf("AtLeast(%v) = %t, want %t", tt.version, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+// Package jira provides a deployment-agnostic facade over the cloud and onpremise clients.
+//
+// Code which should work against Jira Cloud and Jira Server / Data Center alike
+// programs against the Client interface and its normalized models.
+// Adapters for both deployments are created with NewCloud and NewOnPremise.
+//
+// Not every operation is available on every deployment.
+// Each adapter reports the capabilities it does not support via Unsupported,
+// and operations depending on such a capability fail with an error matching ErrUnsupported:
+//
+//	members, err := client.GroupMembers(ctx, group)
+//	if errors.Is(err, jira.ErrUnsupported) {
+//		// degrade gracefully
+//	}
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var (
+	// ErrUnsupported is returned (wrapped in an *UnsupportedError) for operations
+	// the deployment of a Client does not support.
+	ErrUnsupported = errors.New("jira: operation not supported by the deployment")
+
+	// ErrNotFound is returned (wrapped) if Jira responds with 404 Not Found.
+	ErrNotFound = errors.New("jira: not found")
+)
+
+// Deployment is the kind of Jira instance a Client talks to.
+type Deployment string
+
+// Supported deployments.
+const (
+	// Cloud is Jira Cloud (https://<site>.atlassian.net).
+	Cloud Deployment = "cloud"
+	// OnPremise is Jira Server or Jira Data Center.
+	OnPremise Deployment = "onpremise"
+)
+
+// Capability is a feature of the facade which is not available on every deployment.
+type Capability string
+
+// Capabilities of the facade.
+const (
+	// CapabilityAccountIDs identifies users by their Atlassian account ID (Cloud only).
+	CapabilityAccountIDs Capability = "account-ids"
+	// CapabilityUsernames identifies users by their user name (Server / Data Center only).
+	CapabilityUsernames Capability = "usernames"
+	// CapabilityGroupIDs addresses groups by their ID instead of their name (Cloud only).
+	CapabilityGroupIDs Capability = "group-ids"
+	// CapabilityGroupSearch searches groups by a part of their name.
+	CapabilityGroupSearch Capability = "group-search"
+	// CapabilityProjectRoleActors lists and modifies the actors of a project role.
+	CapabilityProjectRoleActors Capability = "project-role-actors"
+	// CapabilityPersonalAccessTokens authenticates with personal access tokens
+	// (Server / Data Center 8.14 and later).
+	CapabilityPersonalAccessTokens Capability = "personal-access-tokens"
+	// CapabilityEnhancedSearch reports that the instance offers the enhanced JQL search API
+	// /rest/api/3/search/jql (Cloud only). It describes the instance, not the facade:
+	// Client.SearchIssues pages by offset and uses the classic search API on all deployments.
+	CapabilityEnhancedSearch Capability = "enhanced-search"
+)
+
+// UnsupportedError is returned for operations which depend on a capability
+// the deployment does not support.
+type UnsupportedError struct {
+	Deployment Deployment
+	Capability Capability
+	Operation  string
+}
+
+// Error returns the error message.
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("jira: %s: capability %q is not supported by %s deployments", e.Operation, e.Capability, e.Deployment)
+}
+
+// Is reports whether target is ErrUnsupported.
+func (e *UnsupportedError) Is(target error) bool {
+	return target == ErrUnsupported
+}
+
+// User is a Jira user.
+//
+// ID is the identifier which is accepted by all methods of a Client:
+// the account ID on Cloud and the user name on Server / Data Center.
+type User struct {
+	ID          string
+	AccountID   string
+	Name        string
+	Key         string
+	Email       string
+	DisplayName string
+	Active      bool
+}
+
+// Group is a Jira group.
+// ID is only set on Cloud, where groups can be renamed.
+type Group struct {
+	ID   string
+	Name string
+}
+
+// Project is a Jira project.
+type Project struct {
+	ID          string
+	Key         string
+	Name        string
+	Description string
+	Lead        *User
+}
+
+// Issue is a Jira issue.
+// Fields holds custom fields keyed by their ID (e.g. customfield_10001).
+type Issue struct {
+	ID          string
+	Key         string
+	ProjectKey  string
+	Type        string
+	Summary     string
+	Description string
+	Status      string
+	Assignee    *User
+	Reporter    *User
+	Created     time.Time
+	Updated     time.Time
+	Fields      map[string]interface{}
+}
+
+// Role is a project role.
+// Actors is only populated by Client.ProjectRole.
+type Role struct {
+	ID          int
+	Name        string
+	Description string
+	Actors      []RoleActor
+}
+
+// RoleActor is a member of a project role: either a user or a group.
+type RoleActor struct {
+	User  *User
+	Group *Group
+}
+
+// SearchOptions specify the page of issues returned by Client.SearchIssues.
+type SearchOptions struct {
+	StartAt    int
+	MaxResults int
+}
+
+// Client is a deployment-agnostic Jira client.
+type Client interface {
+	// Deployment returns the deployment the client talks to.
+	Deployment() Deployment
+	// Supports reports whether the deployment supports the capability.
+	Supports(c Capability) bool
+	// Unsupported returns the capabilities the deployment does not support.
+	Unsupported() []Capability
+
+	// CurrentUser returns the authenticated user.
+	CurrentUser(ctx context.Context) (*User, error)
+	// User returns the user with the given ID.
+	User(ctx context.Context, id string) (*User, error)
+	// FindUsers returns the users matching the query.
+	FindUsers(ctx context.Context, query string) ([]User, error)
+	// UserGroups returns the groups the user with the given ID is a member of.
+	UserGroups(ctx context.Context, id string) ([]Group, error)
+
+	// Issue returns the issue with the given ID or key.
+	Issue(ctx context.Context, idOrKey string) (*Issue, error)
+	// SearchIssues returns a page of issues matching the JQL query.
+	// It uses the classic search API, also on instances supporting CapabilityEnhancedSearch.
+	SearchIssues(ctx context.Context, jql string, options *SearchOptions) ([]Issue, error)
+	// CreateIssue creates the issue and returns it with its ID and key set.
+	CreateIssue(ctx context.Context, issue *Issue) (*Issue, error)
+	// AddComment adds a comment to the issue.
+	AddComment(ctx context.Context, idOrKey, body string) error
+
+	// FindGroups returns the groups whose name contains the query.
+	FindGroups(ctx context.Context, query string) ([]Group, error)
+	// GroupMembers returns all members of the group.
+	GroupMembers(ctx context.Context, group Group) ([]User, error)
+	// AddGroupMember adds the user with the given ID to the group.
+	AddGroupMember(ctx context.Context, group Group, userID string) error
+	// RemoveGroupMember removes the user with the given ID from the group.
+	RemoveGroupMember(ctx context.Context, group Group, userID string) error
+
+	// Project returns the project with the given ID or key.
+	Project(ctx context.Context, idOrKey string) (*Project, error)
+	// Projects returns all projects visible to the user.
+	Projects(ctx context.Context) ([]Project, error)
+
+	// Roles returns all project roles.
+	Roles(ctx context.Context) ([]Role, error)
+	// ProjectRole returns the project role with its actors in the project.
+	ProjectRole(ctx context.Context, projectIDOrKey string, roleID int) (*Role, error)
+	// AddProjectRoleActor adds the user or group to the project role.
+	AddProjectRoleActor(ctx context.Context, projectIDOrKey string, roleID int, actor RoleActor) error
+	// RemoveProjectRoleActor removes the user or group from the project role.
+	RemoveProjectRoleActor(ctx context.Context, projectIDOrKey string, roleID int, actor RoleActor) error
+}
+
+// groupPageSize is the number of group members requested per page.
+const groupPageSize = 50
+
+// capabilities is the set of unsupported capabilities of an adapter.
+type capabilities struct {
+	deployment  Deployment
+	unsupported []Capability
+}
+
+func (c capabilities) Deployment() Deployment {
+	return c.deployment
+}
+
+func (c capabilities) Supports(capability Capability) bool {
+	for _, u := range c.unsupported {
+		if u == capability {
+			return false
+		}
+	}
+	return true
+}
+
+func (c capabilities) Unsupported() []Capability {
+	return append([]Capability(nil), c.unsupported...)
+}
+
+// unsupportedError returns an *UnsupportedError for the operation.
+func (c capabilities) unsupportedError(operation string, capability Capability) error {
+	return &UnsupportedError{Deployment: c.deployment, Capability: capability, Operation: operation}
+}
+
+// wrapError wraps err with ErrNotFound if the status code of the response is 404.
+func wrapError(statusCode int, err error) error {
+	if err == nil {
+		return nil
+	}
+	if statusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return err
+}
+
+// errInvalidActor is returned for role actors which are neither a user nor a group.
+var errInvalidActor = errors.New("jira: role actor must be either a user or a group")
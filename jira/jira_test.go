@@ -0,0 +1,215 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/conductorone/go-jira/v2/cloud"
+	"github.com/conductorone/go-jira/v2/jiratest"
+	"github.com/conductorone/go-jira/v2/onpremise"
+)
+
+// setupClients returns a fake Jira with the project EX, the user dave and the group developers,
+// and a Client of each deployment talking to it.
+func setupClients(t *testing.T) (*jiratest.Server, map[Deployment]Client) {
+	t.Helper()
+	server := jiratest.NewServer()
+	t.Cleanup(server.Close)
+	server.AddProject(jiratest.Project{Key: "EX", Name: "Example"})
+	server.AddUser(jiratest.User{Name: "dave", DisplayName: "Dave", EmailAddress: "dave@example.com", Active: true})
+	if _, err := server.AddGroup("developers"); err != nil {
+		t.Fatal(err)
+	}
+
+	cloudClient, err := cloud.NewClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	onPremiseClient, err := onpremise.NewClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, map[Deployment]Client{
+		Cloud:     NewCloud(cloudClient),
+		OnPremise: NewOnPremise(onPremiseClient),
+	}
+}
+
+func TestClient_Issues(t *testing.T) {
+	_, clients := setupClients(t)
+	ctx := context.Background()
+
+	for deployment, client := range clients {
+		t.Run(string(deployment), func(t *testing.T) {
+			created, err := client.CreateIssue(ctx, &Issue{ProjectKey: "EX", Type: "Bug", Summary: string(deployment)})
+			if err != nil {
+				t.Fatalf("CreateIssue() error = %v", err)
+			}
+			if created.Key == "" || created.Summary != string(deployment) {
+				t.Errorf("CreateIssue() = %+v", created)
+			}
+			if err := client.AddComment(ctx, created.Key, "Hello"); err != nil {
+				t.Errorf("AddComment() error = %v", err)
+			}
+
+			issue, err := client.Issue(ctx, created.Key)
+			if err != nil {
+				t.Fatalf("Issue() error = %v", err)
+			}
+			if issue.ID != created.ID || issue.ProjectKey != "EX" || issue.Status != "To Do" || issue.Created.IsZero() {
+				t.Errorf("Issue() = %+v", issue)
+			}
+
+			issues, err := client.SearchIssues(ctx, "key = "+created.Key, &SearchOptions{MaxResults: 10})
+			if err != nil {
+				t.Fatalf("SearchIssues() error = %v", err)
+			}
+			if len(issues) != 1 || issues[0].Key != created.Key {
+				t.Errorf("SearchIssues() = %+v", issues)
+			}
+
+			if _, err := client.Issue(ctx, "EX-999"); !errors.Is(err, ErrNotFound) {
+				t.Errorf("Issue() of unknown issue error = %v, want ErrNotFound", err)
+			}
+		})
+	}
+}
+
+func TestClient_UsersAndGroups(t *testing.T) {
+	_, clients := setupClients(t)
+	ctx := context.Background()
+
+	for deployment, client := range clients {
+		t.Run(string(deployment), func(t *testing.T) {
+			users, err := client.FindUsers(ctx, "dave")
+			if err != nil {
+				t.Fatalf("FindUsers() error = %v", err)
+			}
+			if len(users) != 1 || users[0].DisplayName != "Dave" || users[0].ID == "" {
+				t.Fatalf("FindUsers() = %+v", users)
+			}
+			dave := users[0]
+			wantID := dave.AccountID
+			if deployment == OnPremise {
+				wantID = dave.Name
+			}
+			if dave.ID != wantID {
+				t.Errorf("FindUsers() ID = %q, want %q", dave.ID, wantID)
+			}
+
+			user, err := client.User(ctx, dave.ID)
+			if err != nil || user.Email != "dave@example.com" {
+				t.Errorf("User() = %+v, %v", user, err)
+			}
+			me, err := client.CurrentUser(ctx)
+			if err != nil || me.Name != "admin" {
+				t.Errorf("CurrentUser() = %+v, %v", me, err)
+			}
+
+			developers := Group{Name: "developers"}
+			if err := client.AddGroupMember(ctx, developers, dave.ID); err != nil {
+				t.Fatalf("AddGroupMember() error = %v", err)
+			}
+			members, err := client.GroupMembers(ctx, developers)
+			if err != nil || len(members) != 1 || members[0].ID != dave.ID {
+				t.Errorf("GroupMembers() = %+v, %v", members, err)
+			}
+			groups, err := client.UserGroups(ctx, dave.ID)
+			if err != nil || len(groups) != 1 || groups[0].Name != "developers" {
+				t.Errorf("UserGroups() = %+v, %v", groups, err)
+			}
+			if err := client.RemoveGroupMember(ctx, developers, dave.ID); err != nil {
+				t.Fatalf("RemoveGroupMember() error = %v", err)
+			}
+			if members, err := client.GroupMembers(ctx, developers); err != nil || len(members) != 0 {
+				t.Errorf("GroupMembers() after removal = %+v, %v", members, err)
+			}
+		})
+	}
+}
+
+func TestClient_ProjectsAndRoles(t *testing.T) {
+	server, clients := setupClients(t)
+	ctx := context.Background()
+
+	for deployment, client := range clients {
+		t.Run(string(deployment), func(t *testing.T) {
+			project, err := client.Project(ctx, "EX")
+			if err != nil || project.Name != "Example" {
+				t.Errorf("Project() = %+v, %v", project, err)
+			}
+			projects, err := client.Projects(ctx)
+			if err != nil || len(projects) != 1 || projects[0].Key != "EX" {
+				t.Errorf("Projects() = %+v, %v", projects, err)
+			}
+			roles, err := client.Roles(ctx)
+			if err != nil || len(roles) != 2 {
+				t.Errorf("Roles() = %+v, %v", roles, err)
+			}
+		})
+	}
+
+	client := clients[Cloud]
+	if err := client.AddProjectRoleActor(ctx, "EX", 10001, RoleActor{Group: &Group{Name: "developers"}}); err != nil {
+		t.Fatalf("AddProjectRoleActor() error = %v", err)
+	}
+	role, err := client.ProjectRole(ctx, "EX", 10001)
+	if err != nil {
+		t.Fatalf("ProjectRole() error = %v", err)
+	}
+	if len(role.Actors) != 1 || role.Actors[0].Group == nil || role.Actors[0].Group.Name != "developers" || role.Actors[0].Group.ID == "" {
+		t.Errorf("ProjectRole() actors = %+v", role.Actors)
+	}
+	if err := client.RemoveProjectRoleActor(ctx, "EX", 10001, role.Actors[0]); err != nil {
+		t.Fatalf("RemoveProjectRoleActor() error = %v", err)
+	}
+	if actors := server.RoleActors("EX", 10001); len(actors) != 0 {
+		t.Errorf("role actors after removal = %v", actors)
+	}
+
+	// Groups can be added and removed by their ID only
+	developers := Group{ID: role.Actors[0].Group.ID}
+	if err := client.AddProjectRoleActor(ctx, "EX", 10001, RoleActor{Group: &developers}); err != nil {
+		t.Fatalf("AddProjectRoleActor() by ID error = %v", err)
+	}
+	if err := client.RemoveProjectRoleActor(ctx, "EX", 10001, RoleActor{Group: &developers}); err != nil {
+		t.Fatalf("RemoveProjectRoleActor() by ID error = %v", err)
+	}
+	if actors := server.RoleActors("EX", 10001); len(actors) != 0 {
+		t.Errorf("role actors after removal by ID = %v", actors)
+	}
+}
+
+func TestClient_Unsupported(t *testing.T) {
+	_, clients := setupClients(t)
+	ctx := context.Background()
+
+	cloudClient, onPremiseClient := clients[Cloud], clients[OnPremise]
+	if cloudClient.Supports(CapabilityUsernames) || !cloudClient.Supports(CapabilityGroupIDs) {
+		t.Errorf("cloud Unsupported() = %v", cloudClient.Unsupported())
+	}
+	if onPremiseClient.Supports(CapabilityProjectRoleActors) || !onPremiseClient.Supports(CapabilityUsernames) {
+		t.Errorf("onpremise Unsupported() = %v", onPremiseClient.Unsupported())
+	}
+
+	_, err := onPremiseClient.FindGroups(ctx, "dev")
+	if !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("FindGroups() error = %v, want ErrUnsupported", err)
+	}
+	var unsupported *UnsupportedError
+	if !errors.As(err, &unsupported) || unsupported.Capability != CapabilityGroupSearch || unsupported.Deployment != OnPremise {
+		t.Errorf("FindGroups() error = %#v", err)
+	}
+	if err := onPremiseClient.AddGroupMember(ctx, Group{ID: "1"}, "dave"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("AddGroupMember() by group ID error = %v, want ErrUnsupported", err)
+	}
+	if _, err := onPremiseClient.ProjectRole(ctx, "EX", 10001); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("ProjectRole() error = %v, want ErrUnsupported", err)
+	}
+
+	groups, err := cloudClient.FindGroups(ctx, "dev")
+	if err != nil || len(groups) != 1 || groups[0].ID == "" {
+		t.Errorf("cloud FindGroups() = %+v, %v", groups, err)
+	}
+}
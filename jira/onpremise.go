@@ -0,0 +1,291 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/conductorone/go-jira/v2/onpremise"
+	"github.com/trivago/tgo/tcontainer"
+)
+
+type onPremiseClient struct {
+	capabilities
+	client *onpremise.Client
+}
+
+// NewOnPremise returns a Client backed by a Jira Server / Data Center client.
+//
+// Users are identified by their user name and groups by their name.
+// Searching groups and managing project role actors are not supported.
+// As the version of the instance is unknown, personal access tokens are assumed to be supported;
+// use Detect to check the version.
+func NewOnPremise(client *onpremise.Client) Client {
+	return newOnPremiseClient(client)
+}
+
+func newOnPremiseClient(client *onpremise.Client) *onPremiseClient {
+	return &onPremiseClient{
+		capabilities: capabilities{
+			deployment: OnPremise,
+			unsupported: []Capability{
+				CapabilityAccountIDs,
+				CapabilityGroupIDs,
+				CapabilityGroupSearch,
+				CapabilityProjectRoleActors,
+				CapabilityEnhancedSearch,
+			},
+		},
+		client: client,
+	}
+}
+
+func onPremiseStatusCode(resp *onpremise.Response) int {
+	if resp == nil || resp.Response == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
+
+func fromOnPremiseUser(u *onpremise.User) *User {
+	if u == nil {
+		return nil
+	}
+	return &User{
+		ID:          u.Name,
+		Name:        u.Name,
+		Key:         u.Key,
+		Email:       u.EmailAddress,
+		DisplayName: u.DisplayName,
+		Active:      u.Active,
+	}
+}
+
+func fromOnPremiseIssue(i *onpremise.Issue) *Issue {
+	issue := &Issue{ID: i.ID, Key: i.Key}
+	f := i.Fields
+	if f == nil {
+		return issue
+	}
+	issue.ProjectKey = f.Project.Key
+	issue.Type = f.Type.Name
+	issue.Summary = f.Summary
+	issue.Description = f.Description
+	if f.Status != nil {
+		issue.Status = f.Status.Name
+	}
+	issue.Assignee = fromOnPremiseUser(f.Assignee)
+	issue.Reporter = fromOnPremiseUser(f.Reporter)
+	issue.Created = time.Time(f.Created)
+	issue.Updated = time.Time(f.Updated)
+	if len(f.Unknowns) > 0 {
+		issue.Fields = make(map[string]interface{}, len(f.Unknowns))
+		for k, v := range f.Unknowns {
+			issue.Fields[k] = v
+		}
+	}
+	return issue
+}
+
+func (c *onPremiseClient) CurrentUser(ctx context.Context) (*User, error) {
+	user, resp, err := c.client.User.GetSelf(ctx)
+	if err != nil {
+		return nil, wrapError(onPremiseStatusCode(resp), err)
+	}
+	return fromOnPremiseUser(user), nil
+}
+
+func (c *onPremiseClient) User(ctx context.Context, id string) (*User, error) {
+	// Server / Data Center looks users up by user name, which the user search supports
+	users, resp, err := c.client.User.Find(ctx, id, onpremise.WithUsername(id), onpremise.WithInactive(true))
+	if err != nil {
+		return nil, wrapError(onPremiseStatusCode(resp), err)
+	}
+	for i := range users {
+		if strings.EqualFold(users[i].Name, id) {
+			return fromOnPremiseUser(&users[i]), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: user %q", ErrNotFound, id)
+}
+
+func (c *onPremiseClient) FindUsers(ctx context.Context, query string) ([]User, error) {
+	users, resp, err := c.client.User.Find(ctx, query, onpremise.WithUsername(query))
+	if err != nil {
+		return nil, wrapError(onPremiseStatusCode(resp), err)
+	}
+	result := make([]User, 0, len(users))
+	for i := range users {
+		result = append(result, *fromOnPremiseUser(&users[i]))
+	}
+	return result, nil
+}
+
+func (c *onPremiseClient) UserGroups(ctx context.Context, id string) ([]Group, error) {
+	groups, resp, err := c.client.User.GetGroups(ctx, id)
+	if err != nil {
+		return nil, wrapError(onPremiseStatusCode(resp), err)
+	}
+	result := make([]Group, 0, len(*groups))
+	for _, g := range *groups {
+		result = append(result, Group{Name: g.Name})
+	}
+	return result, nil
+}
+
+func (c *onPremiseClient) Issue(ctx context.Context, idOrKey string) (*Issue, error) {
+	issue, resp, err := c.client.Issue.Get(ctx, idOrKey, nil)
+	if err != nil {
+		return nil, wrapError(onPremiseStatusCode(resp), err)
+	}
+	return fromOnPremiseIssue(issue), nil
+}
+
+func (c *onPremiseClient) SearchIssues(ctx context.Context, jql string, options *SearchOptions) ([]Issue, error) {
+	var searchOptions *onpremise.SearchOptions
+	if options != nil {
+		searchOptions = &onpremise.SearchOptions{StartAt: options.StartAt, MaxResults: options.MaxResults}
+	}
+	issues, resp, err := c.client.Issue.Search(ctx, jql, searchOptions)
+	if err != nil {
+		return nil, wrapError(onPremiseStatusCode(resp), err)
+	}
+	result := make([]Issue, 0, len(issues))
+	for i := range issues {
+		result = append(result, *fromOnPremiseIssue(&issues[i]))
+	}
+	return result, nil
+}
+
+func (c *onPremiseClient) CreateIssue(ctx context.Context, issue *Issue) (*Issue, error) {
+	fields := &onpremise.IssueFields{
+		Project:     onpremise.Project{Key: issue.ProjectKey},
+		Type:        onpremise.IssueType{Name: issue.Type},
+		Summary:     issue.Summary,
+		Description: issue.Description,
+	}
+	if issue.Assignee != nil {
+		fields.Assignee = &onpremise.User{Name: issue.Assignee.ID}
+	}
+	if issue.Reporter != nil {
+		fields.Reporter = &onpremise.User{Name: issue.Reporter.ID}
+	}
+	if len(issue.Fields) > 0 {
+		fields.Unknowns = tcontainer.MarshalMap{}
+		for k, v := range issue.Fields {
+			fields.Unknowns[k] = v
+		}
+	}
+	created, resp, err := c.client.Issue.Create(ctx, &onpremise.Issue{Fields: fields})
+	if err != nil {
+		return nil, wrapError(onPremiseStatusCode(resp), err)
+	}
+	result := *issue
+	result.ID, result.Key = created.ID, created.Key
+	return &result, nil
+}
+
+func (c *onPremiseClient) AddComment(ctx context.Context, idOrKey, body string) error {
+	_, resp, err := c.client.Issue.AddComment(ctx, idOrKey, &onpremise.Comment{Body: body})
+	return wrapError(onPremiseStatusCode(resp), err)
+}
+
+func (c *onPremiseClient) FindGroups(ctx context.Context, query string) ([]Group, error) {
+	return nil, c.unsupportedError("FindGroups", CapabilityGroupSearch)
+}
+
+func (c *onPremiseClient) GroupMembers(ctx context.Context, group Group) ([]User, error) {
+	if group.Name == "" {
+		return nil, c.unsupportedError("GroupMembers", CapabilityGroupIDs)
+	}
+	var result []User
+	for startAt := 0; ; startAt += groupPageSize {
+		members, resp, err := c.client.Group.Get(ctx, group.Name, &onpremise.GroupSearchOptions{StartAt: startAt, MaxResults: groupPageSize})
+		if err != nil {
+			return nil, wrapError(onPremiseStatusCode(resp), err)
+		}
+		for _, m := range members {
+			result = append(result, User{
+				ID:          m.Name,
+				Name:        m.Name,
+				Key:         m.Key,
+				Email:       m.EmailAddress,
+				DisplayName: m.DisplayName,
+				Active:      m.Active,
+			})
+		}
+		if len(members) < groupPageSize {
+			return result, nil
+		}
+	}
+}
+
+func (c *onPremiseClient) AddGroupMember(ctx context.Context, group Group, userID string) error {
+	if group.Name == "" {
+		return c.unsupportedError("AddGroupMember", CapabilityGroupIDs)
+	}
+	_, resp, err := c.client.Group.Add(ctx, group.Name, userID)
+	return wrapError(onPremiseStatusCode(resp), err)
+}
+
+func (c *onPremiseClient) RemoveGroupMember(ctx context.Context, group Group, userID string) error {
+	if group.Name == "" {
+		return c.unsupportedError("RemoveGroupMember", CapabilityGroupIDs)
+	}
+	resp, err := c.client.Group.Remove(ctx, group.Name, userID)
+	return wrapError(onPremiseStatusCode(resp), err)
+}
+
+func (c *onPremiseClient) Project(ctx context.Context, idOrKey string) (*Project, error) {
+	project, resp, err := c.client.Project.Get(ctx, idOrKey)
+	if err != nil {
+		return nil, wrapError(onPremiseStatusCode(resp), err)
+	}
+	result := &Project{
+		ID:          project.ID,
+		Key:         project.Key,
+		Name:        project.Name,
+		Description: project.Description,
+	}
+	if project.Lead.Name != "" {
+		result.Lead = fromOnPremiseUser(&project.Lead)
+	}
+	return result, nil
+}
+
+func (c *onPremiseClient) Projects(ctx context.Context) ([]Project, error) {
+	projects, resp, err := c.client.Project.GetAll(ctx, nil)
+	if err != nil {
+		return nil, wrapError(onPremiseStatusCode(resp), err)
+	}
+	result := make([]Project, 0, len(*projects))
+	for _, p := range *projects {
+		result = append(result, Project{ID: p.ID, Key: p.Key, Name: p.Name})
+	}
+	return result, nil
+}
+
+func (c *onPremiseClient) Roles(ctx context.Context) ([]Role, error) {
+	roles, resp, err := c.client.Role.GetList(ctx)
+	if err != nil {
+		return nil, wrapError(onPremiseStatusCode(resp), err)
+	}
+	result := make([]Role, 0, len(*roles))
+	for _, r := range *roles {
+		result = append(result, Role{ID: r.ID, Name: r.Name, Description: r.Description})
+	}
+	return result, nil
+}
+
+func (c *onPremiseClient) ProjectRole(ctx context.Context, projectIDOrKey string, roleID int) (*Role, error) {
+	return nil, c.unsupportedError("ProjectRole", CapabilityProjectRoleActors)
+}
+
+func (c *onPremiseClient) AddProjectRoleActor(ctx context.Context, projectIDOrKey string, roleID int, actor RoleActor) error {
+	return c.unsupportedError("AddProjectRoleActor", CapabilityProjectRoleActors)
+}
+
+func (c *onPremiseClient) RemoveProjectRoleActor(ctx context.Context, projectIDOrKey string, roleID int, actor RoleActor) error {
+	return c.unsupportedError("RemoveProjectRoleActor", CapabilityProjectRoleActors)
+}
@@ -0,0 +1,460 @@
+package jiratest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNoInteraction is returned by a Recorder in ModeReplay for requests which were not recorded.
+var ErrNoInteraction = errors.New("jiratest: no recorded interaction matches the request")
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+const (
+	// ModeReplay serves requests from the cassette without network access.
+	// Requests which were not recorded fail with ErrNoInteraction.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to Jira and records them. Save replaces the cassette,
+	// so existing cassettes are re-recorded.
+	ModeRecord
+)
+
+// scrubbed replaces secrets in cassettes.
+const scrubbed = "[REDACTED]"
+
+// scrubbedEmail replaces email addresses in cassettes.
+const scrubbedEmail = "redacted@example.com"
+
+// Cassette is a list of recorded interactions with Jira.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions" yaml:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request" yaml:"request"`
+	Response RecordedResponse `json:"response" yaml:"response"`
+}
+
+// RecordedRequest is a request of an Interaction.
+type RecordedRequest struct {
+	Method string      `json:"method" yaml:"method"`
+	URL    string      `json:"url" yaml:"url"`
+	Header http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body   string      `json:"body,omitempty" yaml:"body,omitempty"`
+
+	// Encoding is "base64" for binary bodies.
+	Encoding string `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+}
+
+// RecordedResponse is a response of an Interaction.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode" yaml:"statusCode"`
+	Header     http.Header `json:"header,omitempty" yaml:"header,omitempty"`
+	Body       string      `json:"body,omitempty" yaml:"body,omitempty"`
+
+	// Encoding is "base64" for binary bodies.
+	Encoding string `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+}
+
+// Recorder is an http.RoundTripper which records the interactions with Jira to a cassette file
+// and replays them, so integration tests run deterministically and without network access.
+//
+// Use it below an auth transport, like the Transport of a BasicAuthTransport:
+//
+//	recorder, err := jiratest.NewRecorder("testdata/issues.yaml", jiratest.ModeReplay)
+//	defer recorder.Save()
+//	tp := cloud.BasicAuthTransport{Username: user, APIToken: token, Transport: recorder}
+//	client, err := cloud.NewClient(baseURL, tp.Client())
+//
+// Cassettes ending in ".yaml" or ".yml" are YAML files, all others JSON files.
+// Credentials, cookies, tokens, passwords and email addresses are scrubbed before they are recorded.
+//
+// Requests are matched by method, path, query and body. The order of query parameters and JSON fields
+// and the boundaries of multipart bodies do not matter. Headers and the host are ignored, so cassettes
+// can be replayed against any base URL and with any credentials. Identical requests are answered with
+// their recorded responses in order.
+type Recorder struct {
+	// Mode is the mode of the recorder.
+	Mode Mode
+
+	// Transport is the underlying HTTP transport to use when recording.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	// Scrub is called for every interaction before it is recorded, to remove further sensitive data.
+	// Requests are scrubbed before they are matched in ModeReplay as well.
+	Scrub func(*Interaction)
+
+	path string
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder returns a Recorder for the cassette at path.
+// In ModeReplay the cassette is loaded and must exist.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{Mode: mode, path: path}
+	if mode != ModeReplay {
+		return r, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("jiratest: loading cassette: %w", err)
+	}
+	if isYAML(path) {
+		err = yaml.Unmarshal(b, &r.cassette)
+	} else {
+		err = json.Unmarshal(b, &r.cassette)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jiratest: decoding cassette %s: %w", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// Save writes the recorded interactions to the cassette file. It does nothing in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.Mode == ModeReplay {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var b []byte
+	var err error
+	if isYAML(r.path) {
+		b, err = yaml.Marshal(&r.cassette)
+	} else {
+		b, err = json.MarshalIndent(&r.cassette, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("jiratest: encoding cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("jiratest: saving cassette: %w", err)
+	}
+	if err := os.WriteFile(r.path, b, 0o644); err != nil {
+		return fmt.Errorf("jiratest: saving cassette: %w", err)
+	}
+	return nil
+}
+
+// Cassette returns a copy of the recorded interactions.
+func (r *Recorder) Cassette() Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return Cassette{Interactions: append([]Interaction(nil), r.cassette.Interactions...)}
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Mode == ModeReplay {
+		return r.replay(req, r.scrub(Interaction{Request: recordRequest(req, body)}).Request)
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := Interaction{Request: recordRequest(req, body), Response: recordResponse(resp, respBody)}
+	interaction = r.scrub(interaction)
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.used = append(r.used, true)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction matching the request.
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	key := matchKey(recorded)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || matchKey(interaction.Request) != key {
+			continue
+		}
+		r.used[i] = true
+		return interaction.Response.response(req)
+	}
+	return nil, fmt.Errorf("%w: %s %s in %s", ErrNoInteraction, recorded.Method, recorded.URL, r.path)
+}
+
+func (r *Recorder) scrub(interaction Interaction) Interaction {
+	interaction.Request.URL = scrubURL(interaction.Request.URL)
+	interaction.Request.Header = scrubHeader(interaction.Request.Header)
+	interaction.Response.Header = scrubHeader(interaction.Response.Header)
+	if interaction.Request.Encoding == "" {
+		interaction.Request.Body = scrubBody(interaction.Request.Body)
+	}
+	if interaction.Response.Encoding == "" {
+		interaction.Response.Body = scrubBody(interaction.Response.Body)
+	}
+	if r.Scrub != nil {
+		r.Scrub(&interaction)
+	}
+	return interaction
+}
+
+func recordRequest(req *http.Request, body []byte) RecordedRequest {
+	recorded := RecordedRequest{Method: req.Method, URL: req.URL.String(), Header: req.Header.Clone()}
+	recorded.Body, recorded.Encoding = encodeRecordedBody(body)
+	return recorded
+}
+
+func recordResponse(resp *http.Response, body []byte) RecordedResponse {
+	recorded := RecordedResponse{StatusCode: resp.StatusCode, Header: resp.Header.Clone()}
+	recorded.Body, recorded.Encoding = encodeRecordedBody(body)
+	return recorded
+}
+
+func (rr *RecordedResponse) response(req *http.Request) (*http.Response, error) {
+	body, err := decodeRecordedBody(rr.Body, rr.Encoding)
+	if err != nil {
+		return nil, fmt.Errorf("jiratest: decoding recorded response body: %w", err)
+	}
+	header := rr.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	// Scrubbing may have changed the length of the body
+	header.Del("Content-Length")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rr.StatusCode, http.StatusText(rr.StatusCode)),
+		StatusCode:    rr.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// readRequestBody returns the body of req and restores it, so it can be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+func encodeRecordedBody(b []byte) (body, encoding string) {
+	if utf8.Valid(b) {
+		return string(b), ""
+	}
+	return base64.StdEncoding.EncodeToString(b), "base64"
+}
+
+func decodeRecordedBody(body, encoding string) ([]byte, error) {
+	if encoding == "base64" {
+		return base64.StdEncoding.DecodeString(body)
+	}
+	return []byte(body), nil
+}
+
+func isYAML(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// matchKey returns the normalized method, path, query and body of a request.
+func matchKey(req RecordedRequest) string {
+	var path, query string
+	if u, err := url.Parse(req.URL); err == nil {
+		// url.Values.Encode sorts by key
+		path, query = u.Path, u.Query().Encode()
+	}
+	body, _ := decodeRecordedBody(req.Body, req.Encoding)
+	return req.Method + " " + path + "?" + query + "\n" + normalizeBody(body, req.Header.Get("Content-Type"))
+}
+
+// normalizeBody returns JSON bodies with sorted fields and multipart bodies without their random boundary.
+func normalizeBody(body []byte, contentType string) string {
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	if strings.HasPrefix(mediaType, "multipart/") && params["boundary"] != "" {
+		var parts []string
+		reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				break
+			}
+			content, _ := io.ReadAll(part)
+			parts = append(parts, part.Header.Get("Content-Disposition")+"\n"+string(content))
+		}
+		return strings.Join(parts, "\n--\n")
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err == nil {
+		// encoding/json sorts the keys of maps
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+	return string(body)
+}
+
+// secretFields are JSON fields and query parameters whose values are scrubbed.
+var secretFields = map[string]bool{
+	"password":      true,
+	"token":         true,
+	"rawtoken":      true,
+	"apitoken":      true,
+	"secret":        true,
+	"sharedsecret":  true,
+	"client_secret": true,
+	"access_token":  true,
+	"refresh_token": true,
+	"oauth_token":   true,
+	"jwt":           true,
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	jwtPattern   = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+)
+
+// scrubText replaces JWTs and email addresses.
+func scrubText(s string) string {
+	s = jwtPattern.ReplaceAllString(s, scrubbed)
+	return emailPattern.ReplaceAllString(s, scrubbedEmail)
+}
+
+// scrubBody replaces secret fields of JSON bodies, and JWTs and email addresses in all bodies.
+func scrubBody(body string) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil || !scrubJSON(v) {
+		return scrubText(body)
+	}
+	// Keep the original formatting unless a secret field was replaced
+	b, err := json.Marshal(v)
+	if err != nil {
+		return scrubText(body)
+	}
+	return scrubText(string(b))
+}
+
+// scrubJSON replaces the values of secret fields and reports whether it changed v.
+func scrubJSON(v interface{}) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if secretFields[strings.ToLower(key)] {
+				v[key] = scrubbed
+				changed = true
+				continue
+			}
+			changed = scrubJSON(value) || changed
+		}
+	case []interface{}:
+		for _, value := range v {
+			changed = scrubJSON(value) || changed
+		}
+	}
+	return changed
+}
+
+func scrubURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return scrubText(rawURL)
+	}
+	u.User = nil
+	q := u.Query()
+	keys := make([]string, 0, len(q))
+	for key := range q {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if secretFields[strings.ToLower(key)] {
+			q.Set(key, scrubbed)
+			continue
+		}
+		for i, value := range q[key] {
+			q[key][i] = scrubText(value)
+		}
+	}
+	if len(q) > 0 {
+		u.RawQuery = q.Encode()
+	}
+	return u.String()
+}
+
+// scrubHeader returns a copy of h with credentials replaced.
+func scrubHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	out := make(http.Header, len(h))
+	for key, values := range h {
+		switch http.CanonicalHeaderKey(key) {
+		case "Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie":
+			out[key] = []string{scrubbed}
+		default:
+			scrubbedValues := make([]string, len(values))
+			for i, value := range values {
+				scrubbedValues[i] = scrubText(value)
+			}
+			out[key] = scrubbedValues
+		}
+	}
+	return out
+}
@@ -0,0 +1,288 @@
+package jiratest
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+func (g *group) has(accountID string) bool {
+	for _, member := range g.members {
+		if member == accountID {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *group) add(accountID string) {
+	if !g.has(accountID) {
+		g.members = append(g.members, accountID)
+	}
+}
+
+func (g *group) remove(accountID string) {
+	for i, member := range g.members {
+		if member == accountID {
+			g.members = append(g.members[:i], g.members[i+1:]...)
+			return
+		}
+	}
+}
+
+// findGroup returns the group with the given name or ID.
+func (s *Server) findGroup(name, id string) *group {
+	for _, g := range s.groups {
+		if (name != "" && strings.EqualFold(g.Name, name)) || (id != "" && g.ID == id) {
+			return g
+		}
+	}
+	return nil
+}
+
+// groupParam returns the group addressed by the groupname or groupId query parameter.
+func (s *Server) groupParam(w http.ResponseWriter, r *http.Request) *group {
+	q := r.URL.Query()
+	name, id := q.Get("groupname"), q.Get("groupId")
+	if name == "" && id == "" {
+		writeError(w, http.StatusBadRequest, "Either a group name or a group ID must be provided.")
+		return nil
+	}
+	g := s.findGroup(name, id)
+	if g == nil {
+		writeError(w, http.StatusNotFound, "Specified group does not exist.")
+	}
+	return g
+}
+
+func (s *Server) groupJSON(g *group) map[string]interface{} {
+	return map[string]interface{}{
+		"name":    g.Name,
+		"groupId": g.ID,
+		"self":    s.self("group?groupId=%s", g.ID),
+	}
+}
+
+func (s *Server) routeGroup(w http.ResponseWriter, r *http.Request, resource []string) {
+	switch strings.Join(resource, "/") {
+	case "group":
+		switch r.Method {
+		case http.MethodPost:
+			var body struct {
+				Name string `json:"name"`
+			}
+			if !decodeBody(w, r, &body) {
+				return
+			}
+			if body.Name == "" {
+				writeFieldErrors(w, map[string]string{"name": "You must specify a group name."})
+				return
+			}
+			if s.findGroup(body.Name, "") != nil {
+				writeError(w, http.StatusBadRequest, "A group or user with this name already exists.")
+				return
+			}
+			writeJSON(w, http.StatusCreated, s.groupJSON(s.addGroup(body.Name)))
+		case http.MethodDelete:
+			g := s.groupParam(w, r)
+			if g == nil {
+				return
+			}
+			s.deleteGroup(g)
+			w.WriteHeader(http.StatusOK)
+		default:
+			methodNotAllowed(w)
+		}
+	case "group/member":
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		s.listGroupMembers(w, r)
+	case "group/user":
+		s.routeGroupUser(w, r)
+	case "group/bulk":
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		s.bulkGroups(w, r)
+	case "groups/picker":
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		s.pickGroups(w, r)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) deleteGroup(g *group) {
+	for i, other := range s.groups {
+		if other == g {
+			s.groups = append(s.groups[:i], s.groups[i+1:]...)
+			break
+		}
+	}
+	for key, actors := range s.roleActors {
+		s.roleActors[key] = removeActor(actors, roleActor{typ: "group", name: g.Name})
+	}
+}
+
+func (s *Server) listGroupMembers(w http.ResponseWriter, r *http.Request) {
+	g := s.groupParam(w, r)
+	if g == nil {
+		return
+	}
+	includeInactive := r.URL.Query().Get("includeInactiveUsers") == "true"
+	var members []*User
+	for _, accountID := range g.members {
+		if u := s.findUser(accountID); u != nil && (u.Active || includeInactive) {
+			members = append(members, u)
+		}
+	}
+
+	startAt, maxResults := page(r, 50)
+	from, to := bounds(startAt, maxResults, len(members))
+	values := make([]interface{}, 0, to-from)
+	for _, u := range members[from:to] {
+		values = append(values, s.userJSON(u))
+	}
+	result := map[string]interface{}{
+		"self":       s.URL + r.URL.RequestURI(),
+		"maxResults": maxResults,
+		"startAt":    startAt,
+		"total":      len(members),
+		"isLast":     to == len(members),
+		"values":     values,
+	}
+	if to < len(members) {
+		result["nextPage"] = s.nextPage(r, to)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// nextPage returns the URL of the page starting at startAt.
+func (s *Server) nextPage(r *http.Request, startAt int) string {
+	q := r.URL.Query()
+	q.Set("startAt", strconv.Itoa(startAt))
+	u := url.URL{Path: r.URL.Path, RawQuery: q.Encode()}
+	return s.URL + u.String()
+}
+
+func (s *Server) routeGroupUser(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		g := s.groupParam(w, r)
+		if g == nil {
+			return
+		}
+		var body struct {
+			AccountID string `json:"accountId"`
+			Name      string `json:"name"`
+		}
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		u := s.findUser(body.AccountID)
+		if u == nil {
+			u = s.findUser(body.Name)
+		}
+		if u == nil {
+			writeError(w, http.StatusNotFound, "The user does not exist.")
+			return
+		}
+		g.add(u.AccountID)
+		writeJSON(w, http.StatusCreated, s.groupJSON(g))
+	case http.MethodDelete:
+		g := s.groupParam(w, r)
+		if g == nil {
+			return
+		}
+		u := s.userParam(r)
+		if u == nil {
+			writeError(w, http.StatusNotFound, "The user does not exist.")
+			return
+		}
+		if !g.has(u.AccountID) {
+			writeError(w, http.StatusBadRequest, "Cannot remove user '"+u.Name+"' from group '"+g.Name+"' since user is not a member of '"+g.Name+"'")
+			return
+		}
+		g.remove(u.AccountID)
+		w.WriteHeader(http.StatusOK)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// bulkGroups lists the groups, optionally filtered by the groupName and groupId parameters.
+func (s *Server) bulkGroups(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	names, ids := q["groupName"], q["groupId"]
+	var matches []*group
+	for _, g := range s.groups {
+		if (len(names) > 0 || len(ids) > 0) && !contains(names, g.Name) && !contains(ids, g.ID) {
+			continue
+		}
+		matches = append(matches, g)
+	}
+
+	startAt, maxResults := page(r, 50)
+	from, to := bounds(startAt, maxResults, len(matches))
+	values := make([]interface{}, 0, to-from)
+	for _, g := range matches[from:to] {
+		values = append(values, map[string]string{"name": g.Name, "groupId": g.ID})
+	}
+	result := map[string]interface{}{
+		"self":       s.URL + r.URL.RequestURI(),
+		"maxResults": maxResults,
+		"startAt":    startAt,
+		"total":      len(matches),
+		"isLast":     to == len(matches),
+		"values":     values,
+	}
+	if to < len(matches) {
+		result["nextPage"] = s.nextPage(r, to)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// pickGroups lists the groups whose name contains the query parameter.
+func (s *Server) pickGroups(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	query := strings.ToLower(q.Get("query"))
+	maxResults, err := strconv.Atoi(q.Get("maxResults"))
+	if err != nil || maxResults <= 0 {
+		maxResults = 20
+	}
+
+	var matches []*group
+	for _, g := range s.groups {
+		if strings.Contains(strings.ToLower(g.Name), query) {
+			matches = append(matches, g)
+		}
+	}
+	groups := []interface{}{}
+	for i, g := range matches {
+		if i == maxResults {
+			break
+		}
+		groups = append(groups, map[string]interface{}{"name": g.Name, "groupId": g.ID, "html": g.Name})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"header": "Showing " + strconv.Itoa(len(groups)) + " of " + strconv.Itoa(len(matches)) + " matching groups",
+		"total":  len(matches),
+		"groups": groups,
+	})
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
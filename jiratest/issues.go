@@ -0,0 +1,426 @@
+package jiratest
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeFormat is the format of timestamps in the Jira API.
+const timeFormat = "2006-01-02T15:04:05.000-0700"
+
+// readOnlyFields are managed by the fake and ignored when sent by the client.
+var readOnlyFields = []string{"project", "status", "created", "updated", "comment", "creator", "reporter"}
+
+func (s *Server) findIssue(idOrKey string) *issue {
+	for _, is := range s.issues {
+		if is.id == idOrKey || strings.EqualFold(is.key, idOrKey) {
+			return is
+		}
+	}
+	return nil
+}
+
+func (s *Server) findStatus(id string) Status {
+	for _, st := range s.statuses {
+		if st.ID == id {
+			return st
+		}
+	}
+	return Status{ID: id}
+}
+
+func (s *Server) routeIssue(w http.ResponseWriter, r *http.Request, resource []string) {
+	if len(resource) == 0 {
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		s.createIssue(w, r)
+		return
+	}
+
+	is := s.findIssue(resource[0])
+	if is == nil {
+		writeError(w, http.StatusNotFound, "Issue does not exist or you do not have permission to see it.")
+		return
+	}
+
+	switch {
+	case len(resource) == 1:
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, s.issueJSON(is))
+		case http.MethodPut:
+			s.updateIssue(w, r, is)
+		case http.MethodDelete:
+			s.deleteIssue(is)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+	case resource[1] == "comment":
+		s.routeComment(w, r, is, resource[2:])
+	case resource[1] == "transitions" && len(resource) == 2:
+		switch r.Method {
+		case http.MethodGet:
+			s.listTransitions(w, is)
+		case http.MethodPost:
+			s.doTransition(w, r, is)
+		default:
+			methodNotAllowed(w)
+		}
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) createIssue(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Fields map[string]interface{} `json:"fields"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	errors := map[string]string{}
+	var project *Project
+	if ref, ok := body.Fields["project"].(map[string]interface{}); ok {
+		key, _ := ref["key"].(string)
+		id, _ := ref["id"].(string)
+		project = s.findProject(key, id)
+	}
+	if project == nil {
+		errors["project"] = "valid project is required"
+	}
+	if summary, _ := body.Fields["summary"].(string); summary == "" {
+		errors["summary"] = "You must specify a summary of the issue."
+	}
+	if len(errors) > 0 {
+		writeFieldErrors(w, errors)
+		return
+	}
+
+	// Like Jira, the numbers of deleted issues are not reused
+	s.issueNumbers[project.Key]++
+	now := time.Now()
+	is := &issue{
+		id:         strconv.Itoa(s.id()),
+		key:        project.Key + "-" + strconv.Itoa(s.issueNumbers[project.Key]),
+		projectKey: project.Key,
+		fields:     map[string]interface{}{},
+		created:    now,
+		updated:    now,
+	}
+	if len(s.statuses) > 0 {
+		is.statusID = s.statuses[0].ID
+	}
+	setFields(is.fields, body.Fields)
+	s.issues = append(s.issues, is)
+
+	writeJSON(w, http.StatusCreated, map[string]string{
+		"id":   is.id,
+		"key":  is.key,
+		"self": s.self("issue/%s", is.id),
+	})
+}
+
+func (s *Server) updateIssue(w http.ResponseWriter, r *http.Request, is *issue) {
+	var body struct {
+		Fields map[string]interface{} `json:"fields"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if summary, ok := body.Fields["summary"]; ok && summary == "" {
+		writeFieldErrors(w, map[string]string{"summary": "You must specify a summary of the issue."})
+		return
+	}
+	setFields(is.fields, body.Fields)
+	is.updated = time.Now()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// setFields copies the fields sent by the client, skipping read-only and empty ones.
+func setFields(dst, src map[string]interface{}) {
+	for key, value := range src {
+		if isEmpty(value) {
+			continue
+		}
+		dst[key] = value
+	}
+	for _, key := range readOnlyFields {
+		delete(dst, key)
+	}
+}
+
+// isEmpty reports whether value is an empty JSON value. IssueFields sends zero values of nested structs as empty objects.
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func (s *Server) deleteIssue(is *issue) {
+	for i, other := range s.issues {
+		if other == is {
+			s.issues = append(s.issues[:i], s.issues[i+1:]...)
+			return
+		}
+	}
+}
+
+// issueJSON returns the JSON representation of an issue.
+func (s *Server) issueJSON(is *issue) map[string]interface{} {
+	fields := make(map[string]interface{}, len(is.fields)+5)
+	for key, value := range is.fields {
+		fields[key] = value
+	}
+	if p := s.findProject(is.projectKey, ""); p != nil {
+		fields["project"] = s.projectJSON(p)
+	}
+	status := s.findStatus(is.statusID)
+	fields["status"] = map[string]string{"self": s.self("status/%s", status.ID), "id": status.ID, "name": status.Name}
+	fields["created"] = is.created.Format(timeFormat)
+	fields["updated"] = is.updated.Format(timeFormat)
+
+	comments := make([]interface{}, 0, len(is.comments))
+	for _, c := range is.comments {
+		comments = append(comments, s.commentJSON(is, c))
+	}
+	fields["comment"] = map[string]interface{}{
+		"comments":   comments,
+		"maxResults": len(comments),
+		"total":      len(comments),
+		"startAt":    0,
+	}
+
+	return map[string]interface{}{
+		"id":     is.id,
+		"key":    is.key,
+		"self":   s.self("issue/%s", is.id),
+		"fields": fields,
+	}
+}
+
+var (
+	// jqlClause matches the supported JQL clauses, like `project = EX` or `status = "In Progress"`.
+	jqlClause = regexp.MustCompile(`(?i)^\s*(project|key|issuekey|status|id)\s*(=|!=|in)\s*(.+?)\s*$`)
+	jqlAnd    = regexp.MustCompile(`(?i)\s+AND\s+`)
+)
+
+// searchIssues implements the JQL search for clauses on project, key, id and status joined by AND.
+func (s *Server) searchIssues(w http.ResponseWriter, r *http.Request) {
+	jql := r.URL.Query().Get("jql")
+	if i := strings.Index(strings.ToUpper(jql), "ORDER BY"); i >= 0 {
+		jql = jql[:i]
+	}
+
+	matches := append([]*issue(nil), s.issues...)
+	if strings.TrimSpace(jql) != "" {
+		for _, clause := range jqlAnd.Split(jql, -1) {
+			m := jqlClause.FindStringSubmatch(clause)
+			if m == nil {
+				writeError(w, http.StatusBadRequest, "Error in the JQL Query: the clause '"+strings.TrimSpace(clause)+"' is not supported by jiratest.")
+				return
+			}
+			field, op, values := strings.ToLower(m[1]), strings.ToLower(m[2]), jqlValues(m[3])
+			var filtered []*issue
+			for _, is := range matches {
+				if s.issueMatches(is, field, values) == (op != "!=") {
+					filtered = append(filtered, is)
+				}
+			}
+			matches = filtered
+		}
+	}
+
+	startAt, maxResults := page(r, 50)
+	from, to := bounds(startAt, maxResults, len(matches))
+	issues := make([]interface{}, 0, to-from)
+	for _, is := range matches[from:to] {
+		issues = append(issues, s.issueJSON(is))
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"expand":     "schema,names",
+		"startAt":    startAt,
+		"maxResults": maxResults,
+		"total":      len(matches),
+		"issues":     issues,
+	})
+}
+
+// jqlValues returns the values of a JQL clause, like `EX` or `("To Do", Done)`.
+func jqlValues(s string) []string {
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "("), ")")
+	var values []string
+	for _, value := range strings.Split(s, ",") {
+		values = append(values, strings.Trim(strings.TrimSpace(value), `"'`))
+	}
+	return values
+}
+
+func (s *Server) issueMatches(is *issue, field string, values []string) bool {
+	for _, value := range values {
+		switch field {
+		case "project":
+			if p := s.findProject(is.projectKey, ""); p != nil && (strings.EqualFold(p.Key, value) || p.ID == value || p.Name == value) {
+				return true
+			}
+		case "key", "issuekey", "id":
+			if strings.EqualFold(is.key, value) || is.id == value {
+				return true
+			}
+		case "status":
+			if st := s.findStatus(is.statusID); st.ID == value || strings.EqualFold(st.Name, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Server) routeComment(w http.ResponseWriter, r *http.Request, is *issue, resource []string) {
+	if len(resource) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			startAt, maxResults := page(r, 50)
+			from, to := bounds(startAt, maxResults, len(is.comments))
+			comments := make([]interface{}, 0, to-from)
+			for _, c := range is.comments[from:to] {
+				comments = append(comments, s.commentJSON(is, c))
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"startAt":    startAt,
+				"maxResults": maxResults,
+				"total":      len(is.comments),
+				"comments":   comments,
+			})
+		case http.MethodPost:
+			var body struct {
+				Body string `json:"body"`
+			}
+			if !decodeBody(w, r, &body) {
+				return
+			}
+			if body.Body == "" {
+				writeFieldErrors(w, map[string]string{"comment": "Comment body can not be empty!"})
+				return
+			}
+			now := time.Now()
+			c := &Comment{ID: strconv.Itoa(s.id()), Body: body.Body, Author: s.currentUser, Created: now, Updated: now}
+			is.comments = append(is.comments, c)
+			writeJSON(w, http.StatusCreated, s.commentJSON(is, c))
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	index := -1
+	for i, c := range is.comments {
+		if c.ID == resource[0] {
+			index = i
+		}
+	}
+	if index < 0 || len(resource) > 1 {
+		writeError(w, http.StatusNotFound, "Can not find a comment for the id: "+resource[0]+".")
+		return
+	}
+	c := is.comments[index]
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.commentJSON(is, c))
+	case http.MethodPut:
+		var body struct {
+			Body string `json:"body"`
+		}
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		c.Body, c.Updated = body.Body, time.Now()
+		writeJSON(w, http.StatusOK, s.commentJSON(is, c))
+	case http.MethodDelete:
+		is.comments = append(is.comments[:index], is.comments[index+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) commentJSON(is *issue, c *Comment) map[string]interface{} {
+	author := s.userJSON(s.findUser(c.Author))
+	return map[string]interface{}{
+		"self":         s.self("issue/%s/comment/%s", is.id, c.ID),
+		"id":           c.ID,
+		"author":       author,
+		"updateAuthor": author,
+		"body":         c.Body,
+		"created":      c.Created.Format(timeFormat),
+		"updated":      c.Updated.Format(timeFormat),
+	}
+}
+
+func (s *Server) listTransitions(w http.ResponseWriter, is *issue) {
+	transitions := []interface{}{}
+	for _, t := range s.transitions {
+		if t.To == is.statusID {
+			continue
+		}
+		to := s.findStatus(t.To)
+		transitions = append(transitions, map[string]interface{}{
+			"id":   t.ID,
+			"name": t.Name,
+			"to": map[string]string{
+				"self": s.self("status/%s", to.ID),
+				"id":   to.ID,
+				"name": to.Name,
+			},
+			"fields": map[string]interface{}{},
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"expand": "transitions", "transitions": transitions})
+}
+
+func (s *Server) doTransition(w http.ResponseWriter, r *http.Request, is *issue) {
+	var body struct {
+		Transition struct {
+			ID string `json:"id"`
+		} `json:"transition"`
+		Fields map[string]interface{} `json:"fields"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	for _, t := range s.transitions {
+		if t.ID == body.Transition.ID && t.To != is.statusID {
+			is.statusID = t.To
+			setFields(is.fields, body.Fields)
+			is.updated = time.Now()
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeError(w, http.StatusBadRequest, "Transition id '"+body.Transition.ID+"' is not valid for this issue.")
+}
+
+func (s *Server) listStatuses(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	statuses := make([]interface{}, 0, len(s.statuses))
+	for _, st := range s.statuses {
+		statuses = append(statuses, map[string]string{"self": s.self("status/%s", st.ID), "id": st.ID, "name": st.Name})
+	}
+	writeJSON(w, http.StatusOK, statuses)
+}
@@ -0,0 +1,330 @@
+package jiratest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// findProject returns the project with the given key or ID.
+func (s *Server) findProject(key, id string) *Project {
+	for _, p := range s.projects {
+		if (key != "" && strings.EqualFold(p.Key, key)) || (id != "" && p.ID == id) {
+			return p
+		}
+	}
+	return nil
+}
+
+func (s *Server) findRole(id int) *Role {
+	for _, role := range s.roles {
+		if role.ID == id {
+			return role
+		}
+	}
+	return nil
+}
+
+func (s *Server) findPermissionScheme(id int) *PermissionScheme {
+	for _, ps := range s.schemes {
+		if ps.ID == id {
+			return ps
+		}
+	}
+	return nil
+}
+
+func (s *Server) projectJSON(p *Project) map[string]interface{} {
+	roles := make(map[string]string, len(s.roles))
+	for _, role := range s.roles {
+		roles[role.Name] = s.self("project/%s/role/%d", p.ID, role.ID)
+	}
+	return map[string]interface{}{
+		"self":           s.self("project/%s", p.ID),
+		"id":             p.ID,
+		"key":            p.Key,
+		"name":           p.Name,
+		"projectTypeKey": "software",
+		"roles":          roles,
+	}
+}
+
+func (s *Server) routeProject(w http.ResponseWriter, r *http.Request, resource []string) {
+	if r.Method != http.MethodGet && (len(resource) < 2 || resource[1] != "role") {
+		methodNotAllowed(w)
+		return
+	}
+	if len(resource) == 0 {
+		projects := make([]interface{}, 0, len(s.projects))
+		for _, p := range s.projects {
+			projects = append(projects, s.projectJSON(p))
+		}
+		writeJSON(w, http.StatusOK, projects)
+		return
+	}
+
+	p := s.findProject(resource[0], resource[0])
+	if p == nil {
+		writeError(w, http.StatusNotFound, "No project could be found with key '"+resource[0]+"'.")
+		return
+	}
+	switch {
+	case len(resource) == 1:
+		writeJSON(w, http.StatusOK, s.projectJSON(p))
+	case resource[1] == "permissionscheme" && len(resource) == 2:
+		ps := s.findPermissionScheme(p.PermissionSchemeID)
+		if ps == nil {
+			ps = s.findPermissionScheme(DefaultPermissionSchemeID)
+		}
+		writeJSON(w, http.StatusOK, s.permissionSchemeJSON(ps))
+	case resource[1] == "role" && len(resource) == 2:
+		roles := make(map[string]string, len(s.roles))
+		for _, role := range s.roles {
+			roles[role.Name] = s.self("project/%s/role/%d", p.ID, role.ID)
+		}
+		writeJSON(w, http.StatusOK, roles)
+	case resource[1] == "role" && len(resource) == 3:
+		s.routeProjectRole(w, r, p, resource[2])
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) routeProjectRole(w http.ResponseWriter, r *http.Request, p *Project, roleID string) {
+	id, _ := strconv.Atoi(roleID)
+	role := s.findRole(id)
+	if role == nil {
+		writeError(w, http.StatusNotFound, "Can not retrieve a role actor for a null project role.")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		var body struct {
+			User    []string `json:"user"`
+			Group   []string `json:"group"`
+			GroupID []string `json:"groupId"`
+		}
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		if !s.addRoleActors(w, p.Key, role.ID, body.User, append(body.Group, body.GroupID...)) {
+			return
+		}
+	case http.MethodPut:
+		var body struct {
+			CategorisedActors map[string][]string `json:"categorisedActors"`
+		}
+		if !decodeBody(w, r, &body) {
+			return
+		}
+		previous := s.roleActors[roleKey(p.Key, role.ID)]
+		delete(s.roleActors, roleKey(p.Key, role.ID))
+		if !s.addRoleActors(w, p.Key, role.ID, body.CategorisedActors["atlassian-user-role-actor"], body.CategorisedActors["atlassian-group-role-actor"]) {
+			s.roleActors[roleKey(p.Key, role.ID)] = previous
+			return
+		}
+	case http.MethodDelete:
+		q := r.URL.Query()
+		actor := roleActor{typ: "user"}
+		if u := s.findUser(q.Get("user")); u != nil {
+			actor.name = u.AccountID
+		} else if g := s.findGroup(q.Get("group"), q.Get("groupId")); g != nil {
+			actor = roleActor{typ: "group", name: g.Name}
+		}
+		actors := s.roleActors[roleKey(p.Key, role.ID)]
+		remaining := removeActor(actors, actor)
+		if len(remaining) == len(actors) {
+			writeError(w, http.StatusNotFound, "The actor is not a member of the project role.")
+			return
+		}
+		s.roleActors[roleKey(p.Key, role.ID)] = remaining
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		methodNotAllowed(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.roleJSON(p, role))
+}
+
+// addRoleActors adds users and groups to a role and writes an error response if one of them is unknown.
+func (s *Server) addRoleActors(w http.ResponseWriter, projectKey string, roleID int, users, groups []string) bool {
+	for _, u := range users {
+		if err := s.addRoleActor(projectKey, roleID, "user", u); err != nil {
+			writeError(w, http.StatusBadRequest, "We couldn't find the user with key '"+u+"'.")
+			return false
+		}
+	}
+	for _, g := range groups {
+		if err := s.addRoleActor(projectKey, roleID, "group", g); err != nil {
+			writeError(w, http.StatusBadRequest, "We couldn't find the group with name '"+g+"'.")
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) addRoleActor(projectKey string, roleID int, actorType, actor string) error {
+	if s.findProject(projectKey, "") == nil {
+		return fmt.Errorf("jiratest: unknown project %q", projectKey)
+	}
+	if s.findRole(roleID) == nil {
+		return fmt.Errorf("jiratest: unknown role %d", roleID)
+	}
+	a := roleActor{typ: actorType}
+	switch actorType {
+	case "user":
+		u := s.findUser(actor)
+		if u == nil {
+			return fmt.Errorf("jiratest: unknown user %q", actor)
+		}
+		a.name = u.AccountID
+	case "group":
+		g := s.findGroup(actor, actor)
+		if g == nil {
+			return fmt.Errorf("jiratest: unknown group %q", actor)
+		}
+		a.name = g.Name
+	default:
+		return fmt.Errorf("jiratest: unknown actor type %q", actorType)
+	}
+
+	key := roleKey(projectKey, roleID)
+	for _, existing := range s.roleActors[key] {
+		if existing == a {
+			return nil
+		}
+	}
+	s.roleActors[key] = append(s.roleActors[key], a)
+	return nil
+}
+
+func roleKey(projectKey string, roleID int) string {
+	return strings.ToUpper(projectKey) + "/" + strconv.Itoa(roleID)
+}
+
+func removeActor(actors []roleActor, actor roleActor) []roleActor {
+	var remaining []roleActor
+	for _, a := range actors {
+		if a != actor {
+			remaining = append(remaining, a)
+		}
+	}
+	return remaining
+}
+
+func (s *Server) roleJSON(p *Project, role *Role) map[string]interface{} {
+	result := map[string]interface{}{
+		"self":        s.self("role/%d", role.ID),
+		"name":        role.Name,
+		"id":          role.ID,
+		"description": role.Description,
+	}
+	if p == nil {
+		return result
+	}
+
+	result["self"] = s.self("project/%s/role/%d", p.ID, role.ID)
+	actors := []interface{}{}
+	for i, a := range s.roleActors[roleKey(p.Key, role.ID)] {
+		actor := map[string]interface{}{"id": i + 1}
+		switch a.typ {
+		case "user":
+			u := s.findUser(a.name)
+			if u == nil {
+				continue
+			}
+			actor["type"] = "atlassian-user-role-actor"
+			actor["name"] = u.Name
+			actor["displayName"] = u.DisplayName
+			actor["actorUser"] = map[string]string{"accountId": u.AccountID}
+		case "group":
+			g := s.findGroup(a.name, "")
+			if g == nil {
+				continue
+			}
+			actor["type"] = "atlassian-group-role-actor"
+			actor["name"] = g.Name
+			actor["displayName"] = g.Name
+			actor["actorGroup"] = map[string]string{"name": g.Name, "displayName": g.Name, "groupId": g.ID}
+		}
+		actors = append(actors, actor)
+	}
+	result["actors"] = actors
+	return result
+}
+
+func (s *Server) routeRole(w http.ResponseWriter, r *http.Request, resource []string) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	switch len(resource) {
+	case 0:
+		roles := make([]interface{}, 0, len(s.roles))
+		for _, role := range s.roles {
+			roles = append(roles, s.roleJSON(nil, role))
+		}
+		writeJSON(w, http.StatusOK, roles)
+	case 1:
+		id, _ := strconv.Atoi(resource[0])
+		role := s.findRole(id)
+		if role == nil {
+			writeError(w, http.StatusNotFound, "The project role with ID "+resource[0]+" does not exist.")
+			return
+		}
+		writeJSON(w, http.StatusOK, s.roleJSON(nil, role))
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) permissionSchemeJSON(ps *PermissionScheme) map[string]interface{} {
+	permissions := make([]interface{}, 0, len(ps.Permissions))
+	for _, p := range ps.Permissions {
+		permissions = append(permissions, map[string]interface{}{
+			"id":         p.ID,
+			"self":       s.self("permissionscheme/%d/permission/%d", ps.ID, p.ID),
+			"permission": p.Permission,
+			"holder": map[string]string{
+				"type":      p.HolderType,
+				"parameter": p.HolderParameter,
+			},
+		})
+	}
+	return map[string]interface{}{
+		"self":        s.self("permissionscheme/%d", ps.ID),
+		"id":          ps.ID,
+		"name":        ps.Name,
+		"description": ps.Description,
+		"permissions": permissions,
+	}
+}
+
+func (s *Server) routePermissionScheme(w http.ResponseWriter, r *http.Request, resource []string) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	switch len(resource) {
+	case 0:
+		schemes := make([]interface{}, 0, len(s.schemes))
+		for _, ps := range s.schemes {
+			schemes = append(schemes, s.permissionSchemeJSON(ps))
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"permissionSchemes": schemes})
+	case 1:
+		id, err := strconv.Atoi(resource[0])
+		ps := s.findPermissionScheme(id)
+		if err != nil || ps == nil {
+			writeError(w, http.StatusNotFound, "A permission scheme with the id '"+resource[0]+"' does not exist.")
+			return
+		}
+		writeJSON(w, http.StatusOK, s.permissionSchemeJSON(ps))
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
@@ -0,0 +1,467 @@
+// Package jiratest provides an in-memory fake Jira for tests of code using the cloud and onpremise packages.
+//
+// The fake keeps issues, comments, transitions, users, groups, group memberships, project roles and permission
+// schemes in memory and answers with the JSON bodies, pagination and error bodies of Jira:
+//
+//	server := jiratest.NewServer()
+//	defer server.Close()
+//	server.AddProject(jiratest.Project{Key: "EX", Name: "Example"})
+//
+//	client, _ := cloud.NewClient(server.URL, nil)
+//	issue, _, err := client.Issue.Create(ctx, &cloud.Issue{...})
+//
+// Faults like rate limiting, server errors and latency can be injected with Server.InjectFault.
+//
+// Only the parts of the Jira API used by the services of this library are implemented,
+// with a simplified permission model: every request acts as the current user, who may do everything.
+package jiratest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultPermissionSchemeID is the ID of the permission scheme of projects without one.
+const DefaultPermissionSchemeID = 0
+
+// Server is a fake Jira. It serves both the API version 2 and 3 paths.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+
+	info        ServerInfo
+	currentUser string // account ID
+	users       []*User
+	groups      []*group
+	projects    []*Project
+	statuses    []Status
+	transitions []Transition
+	issues      []*issue
+	roles       []*Role
+	roleActors  map[string][]roleActor // key: project key + "/" + role ID
+	schemes     []*PermissionScheme
+	faults      []*Fault
+
+	nextID       int
+	issueNumbers map[string]int // key: project key, value: number of the last issue
+}
+
+type group struct {
+	Group
+	members []string // account IDs
+}
+
+type issue struct {
+	id, key, projectKey, statusID string
+	fields                        map[string]interface{}
+	comments                      []*Comment
+	created, updated              time.Time
+}
+
+type roleActor struct {
+	typ  string // "user" or "group"
+	name string // account ID or group name
+}
+
+// NewServer starts a fake Jira. The caller must call Close when finished.
+//
+// The fake reports itself as Jira Cloud and has a current user "admin", the statuses "To Do", "In Progress"
+// and "Done" with a transition to each of them, the project roles "Administrators" and "Developers"
+// and the default permission scheme.
+func NewServer() *Server {
+	s := &Server{
+		info: ServerInfo{Version: "1001.0.0-SNAPSHOT", DeploymentType: "Cloud", BuildNumber: 100264},
+		statuses: []Status{
+			{ID: "1", Name: "To Do"},
+			{ID: "3", Name: "In Progress"},
+			{ID: "10001", Name: "Done"},
+		},
+		transitions: []Transition{
+			{ID: "11", Name: "To Do", To: "1"},
+			{ID: "21", Name: "In Progress", To: "3"},
+			{ID: "31", Name: "Done", To: "10001"},
+		},
+		roles: []*Role{
+			{ID: 10002, Name: "Administrators", Description: "A project role that represents administrators in a project"},
+			{ID: 10001, Name: "Developers", Description: "A project role that represents developers in a project"},
+		},
+		schemes: []*PermissionScheme{
+			{ID: DefaultPermissionSchemeID, Name: "Default Permission Scheme", Description: "This is the default Permission Scheme.", Permissions: []Permission{
+				{ID: 10000, Permission: "BROWSE_PROJECTS", HolderType: "applicationRole"},
+				{ID: 10001, Permission: "ADMINISTER_PROJECTS", HolderType: "projectRole", HolderParameter: "10002"},
+			}},
+		},
+		roleActors:   make(map[string][]roleActor),
+		nextID:       10000,
+		issueNumbers: make(map[string]int),
+	}
+	admin := s.AddUser(User{Name: "admin", DisplayName: "Administrator", EmailAddress: "admin@example.com", Active: true})
+	s.currentUser = admin.AccountID
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// SetServerInfo sets the version and deployment type reported by the serverInfo resource.
+func (s *Server) SetServerInfo(info ServerInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.info = info
+}
+
+// SetCurrentUser sets the user the requests are made as, identified by account ID, name or key.
+func (s *Server) SetCurrentUser(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u := s.findUser(id)
+	if u == nil {
+		return fmt.Errorf("jiratest: unknown user %q", id)
+	}
+	s.currentUser = u.AccountID
+	return nil
+}
+
+// AddUser adds a user and returns it with defaults for the missing identifiers.
+func (s *Server) AddUser(u User) User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.addUser(u)
+}
+
+func (s *Server) addUser(u User) *User {
+	if u.AccountID == "" {
+		u.AccountID = fmt.Sprintf("5b10ac8d82e05b22cc7d%04d", s.id())
+	}
+	if u.Name == "" {
+		u.Name = u.AccountID
+	}
+	if u.Key == "" {
+		u.Key = "JIRAUSER" + strconv.Itoa(s.id())
+	}
+	if u.DisplayName == "" {
+		u.DisplayName = u.Name
+	}
+	s.users = append(s.users, &u)
+	return &u
+}
+
+// AddGroup adds a group with the given members, identified by account ID, name or key.
+func (s *Server) AddGroup(name string, members ...string) (Group, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g := s.addGroup(name)
+	for _, member := range members {
+		u := s.findUser(member)
+		if u == nil {
+			return g.Group, fmt.Errorf("jiratest: unknown user %q", member)
+		}
+		g.add(u.AccountID)
+	}
+	return g.Group, nil
+}
+
+func (s *Server) addGroup(name string) *group {
+	g := &group{Group: Group{ID: fmt.Sprintf("276f955c-63d7-42c8-9520-92d01dca%04d", s.id()), Name: name}}
+	s.groups = append(s.groups, g)
+	return g
+}
+
+// GroupMembers returns the account IDs of the members of a group.
+func (s *Server) GroupMembers(name string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if g := s.findGroup(name, ""); g != nil {
+		return append([]string(nil), g.members...)
+	}
+	return nil
+}
+
+// AddProject adds a project and returns it with defaults for the missing fields.
+func (s *Server) AddProject(p Project) Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p.ID == "" {
+		p.ID = strconv.Itoa(s.id())
+	}
+	if p.Name == "" {
+		p.Name = p.Key
+	}
+	s.projects = append(s.projects, &p)
+	return p
+}
+
+// AddRole adds a project role.
+func (s *Server) AddRole(r Role) Role {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.ID == 0 {
+		r.ID = s.id()
+	}
+	s.roles = append(s.roles, &r)
+	return r
+}
+
+// AddRoleActor adds a user (identified by account ID, name or key) or a group (identified by name)
+// to a role of a project. actorType is "user" or "group".
+func (s *Server) AddRoleActor(projectKey string, roleID int, actorType, actor string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addRoleActor(projectKey, roleID, actorType, actor)
+}
+
+// RoleActors returns the actors of a role of a project, as "user:<account ID>" or "group:<name>".
+func (s *Server) RoleActors(projectKey string, roleID int) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var actors []string
+	for _, a := range s.roleActors[roleKey(projectKey, roleID)] {
+		actors = append(actors, a.typ+":"+a.name)
+	}
+	return actors
+}
+
+// AddPermissionScheme adds a permission scheme.
+func (s *Server) AddPermissionScheme(ps PermissionScheme) PermissionScheme {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ps.ID == 0 {
+		ps.ID = s.id()
+	}
+	s.schemes = append(s.schemes, &ps)
+	return ps
+}
+
+// SetWorkflow replaces the statuses and transitions of all issues.
+// New issues start in the first status.
+func (s *Server) SetWorkflow(statuses []Status, transitions []Transition) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses = append([]Status(nil), statuses...)
+	s.transitions = append([]Transition(nil), transitions...)
+}
+
+// Issue returns a snapshot of the issue with the given ID or key.
+func (s *Server) Issue(idOrKey string) (Issue, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	is := s.findIssue(idOrKey)
+	if is == nil {
+		return Issue{}, false
+	}
+	snapshot := Issue{
+		ID:         is.id,
+		Key:        is.key,
+		ProjectKey: is.projectKey,
+		StatusID:   is.statusID,
+		Fields:     make(map[string]interface{}, len(is.fields)),
+	}
+	for k, v := range is.fields {
+		snapshot.Fields[k] = v
+	}
+	for _, c := range is.comments {
+		snapshot.Comments = append(snapshot.Comments, *c)
+	}
+	return snapshot, true
+}
+
+// InjectFault makes the server misbehave for matching requests.
+// Faults are checked in the order they were injected; the first matching one applies.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// id returns a new numeric ID.
+func (s *Server) id() int {
+	s.nextID++
+	return s.nextID
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if fault := s.fault(r); fault != nil {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault.StatusCode != 0 {
+			if fault.RetryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(fault.RetryAfter.Round(time.Second)/time.Second)))
+			}
+			writeError(w, fault.StatusCode, http.StatusText(fault.StatusCode))
+			return
+		}
+	}
+
+	resource, ok := apiResource(r.URL.Path)
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.route(w, r, resource)
+}
+
+// fault returns the first fault matching r, if any.
+func (s *Server) fault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, f := range s.faults {
+		if (f.Method != "" && f.Method != r.Method) || !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		fault := *f
+		return &fault
+	}
+	return nil
+}
+
+// apiResource returns the path below "/rest/api/<version>/", split into segments.
+func apiResource(path string) ([]string, bool) {
+	for _, prefix := range []string{"/rest/api/2/", "/rest/api/3/", "/rest/api/latest/"} {
+		if rest, ok := strings.CutPrefix(path, prefix); ok {
+			return strings.Split(strings.Trim(rest, "/"), "/"), true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) route(w http.ResponseWriter, r *http.Request, resource []string) {
+	switch resource[0] {
+	case "issue":
+		s.routeIssue(w, r, resource[1:])
+	case "search":
+		s.searchIssues(w, r)
+	case "myself":
+		s.getMyself(w, r)
+	case "user":
+		s.routeUser(w, r, resource[1:])
+	case "group", "groups":
+		s.routeGroup(w, r, resource)
+	case "project":
+		s.routeProject(w, r, resource[1:])
+	case "role":
+		s.routeRole(w, r, resource[1:])
+	case "permissionscheme":
+		s.routePermissionScheme(w, r, resource[1:])
+	case "status":
+		s.listStatuses(w, r)
+	case "serverInfo":
+		s.getServerInfo(w, r)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) getServerInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	versionNumbers := []int{}
+	for _, part := range strings.Split(strings.SplitN(s.info.Version, "-", 2)[0], ".") {
+		if n, err := strconv.Atoi(part); err == nil {
+			versionNumbers = append(versionNumbers, n)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"baseUrl":        s.URL,
+		"version":        s.info.Version,
+		"versionNumbers": versionNumbers,
+		"deploymentType": s.info.DeploymentType,
+		"buildNumber":    s.info.BuildNumber,
+		"serverTime":     time.Now().Format(timeFormat),
+		"serverTitle":    "Jira",
+	})
+}
+
+// self returns the URL of an API resource.
+func (s *Server) self(format string, a ...interface{}) string {
+	return s.URL + "/rest/api/2/" + fmt.Sprintf(format, a...)
+}
+
+// writeJSON writes v as JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error body like Jira does.
+func writeError(w http.ResponseWriter, status int, messages ...string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errorMessages": messages,
+		"errors":        map[string]string{},
+	})
+}
+
+// writeFieldErrors writes an error body with field errors like Jira does.
+func writeFieldErrors(w http.ResponseWriter, errors map[string]string) {
+	writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+		"errorMessages": []string{},
+		"errors":        errors,
+	})
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+}
+
+// page returns the startAt and maxResults query parameters.
+func page(r *http.Request, defaultMaxResults int) (startAt, maxResults int) {
+	startAt, _ = strconv.Atoi(r.URL.Query().Get("startAt"))
+	maxResults, err := strconv.Atoi(r.URL.Query().Get("maxResults"))
+	if err != nil || maxResults <= 0 {
+		maxResults = defaultMaxResults
+	}
+	if startAt < 0 {
+		startAt = 0
+	}
+	return startAt, maxResults
+}
+
+// bounds returns the slice bounds of a page of total items.
+func bounds(startAt, maxResults, total int) (from, to int) {
+	from = startAt
+	if from > total {
+		from = total
+	}
+	to = from + maxResults
+	if to > total {
+		to = total
+	}
+	return from, to
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Unexpected request body: "+err.Error())
+		return false
+	}
+	return true
+}
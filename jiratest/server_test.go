@@ -0,0 +1,351 @@
+package jiratest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/conductorone/go-jira/v2/cloud"
+	"github.com/conductorone/go-jira/v2/onpremise"
+)
+
+func setupServer(t *testing.T) *Server {
+	t.Helper()
+	server := NewServer()
+	t.Cleanup(server.Close)
+	server.AddProject(Project{Key: "EX", Name: "Example"})
+	return server
+}
+
+func TestServer_CloudIssues(t *testing.T) {
+	server := setupServer(t)
+	client, err := cloud.NewClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	for _, summary := range []string{"First", "Second", "Third"} {
+		_, _, err := client.Issue.Create(ctx, &cloud.Issue{Fields: &cloud.IssueFields{
+			Project: cloud.Project{Key: "EX"},
+			Type:    cloud.IssueType{Name: "Bug"},
+			Summary: summary,
+		}})
+		if err != nil {
+			t.Fatalf("Create(%q) error = %v", summary, err)
+		}
+	}
+
+	issue, _, err := client.Issue.Get(ctx, "EX-2", nil)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if issue.Fields.Summary != "Second" || issue.Fields.Status.Name != "To Do" || issue.Fields.Project.Key != "EX" {
+		t.Errorf("Get() = %q in status %q of project %q", issue.Fields.Summary, issue.Fields.Status.Name, issue.Fields.Project.Key)
+	}
+
+	if _, err := client.Issue.UpdateIssue(ctx, "EX-2", map[string]interface{}{
+		"fields": map[string]interface{}{"summary": "Updated", "customfield_10001": "value"},
+	}); err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+	if snapshot, _ := server.Issue("EX-2"); snapshot.Fields["summary"] != "Updated" || snapshot.Fields["customfield_10001"] != "value" {
+		t.Errorf("fields after update = %v", snapshot.Fields)
+	}
+
+	issues, resp, err := client.Issue.Search(ctx, "project = EX ORDER BY created", &cloud.SearchOptions{StartAt: 1, MaxResults: 1})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(issues) != 1 || issues[0].Key != "EX-2" || resp.Total != 3 || resp.StartAt != 1 {
+		t.Errorf("Search() = %d issues, total %d, startAt %d", len(issues), resp.Total, resp.StartAt)
+	}
+
+	comment, _, err := client.Issue.AddComment(ctx, "EX-1", &cloud.Comment{Body: "Hello"})
+	if err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+	if comment.Author == nil || comment.Author.Name != "admin" {
+		t.Errorf("AddComment() author = %v, want admin", comment.Author)
+	}
+	if _, _, err := client.Issue.UpdateComment(ctx, "EX-1", &cloud.Comment{ID: comment.ID, Body: "Bye"}); err != nil {
+		t.Fatalf("UpdateComment() error = %v", err)
+	}
+	if snapshot, _ := server.Issue("EX-1"); len(snapshot.Comments) != 1 || snapshot.Comments[0].Body != "Bye" {
+		t.Errorf("comments after update = %v", snapshot.Comments)
+	}
+	if err := client.Issue.DeleteComment(ctx, "EX-1", comment.ID); err != nil {
+		t.Fatalf("DeleteComment() error = %v", err)
+	}
+
+	transitions, _, err := client.Issue.GetTransitions(ctx, "EX-1")
+	if err != nil {
+		t.Fatalf("GetTransitions() error = %v", err)
+	}
+	if len(transitions) != 2 {
+		t.Fatalf("GetTransitions() = %d transitions, want 2", len(transitions))
+	}
+	for _, transition := range transitions {
+		if transition.To.Name == "Done" {
+			if _, err := client.Issue.DoTransition(ctx, "EX-1", transition.ID); err != nil {
+				t.Fatalf("DoTransition() error = %v", err)
+			}
+		}
+	}
+	issues, _, err = client.Issue.Search(ctx, `project = EX AND status = "Done"`, nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(issues) != 1 || issues[0].Key != "EX-1" {
+		t.Errorf("Search() for done issues = %v", issues)
+	}
+
+	if _, err := client.Issue.Delete(ctx, "EX-3"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, ok := server.Issue("EX-3"); ok {
+		t.Error("issue exists after Delete()")
+	}
+
+	// The number of the deleted issue is not reused
+	created, _, err := client.Issue.Create(ctx, &cloud.Issue{Fields: &cloud.IssueFields{
+		Project: cloud.Project{Key: "EX"},
+		Type:    cloud.IssueType{Name: "Bug"},
+		Summary: "Fourth",
+	}})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.Key != "EX-4" {
+		t.Errorf("Create() after Delete() = %s, want EX-4", created.Key)
+	}
+}
+
+func TestServer_OnPremiseIssues(t *testing.T) {
+	server := setupServer(t)
+	client, err := onpremise.NewClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	created, _, err := client.Issue.Create(ctx, &onpremise.Issue{Fields: &onpremise.IssueFields{
+		Project: onpremise.Project{Key: "EX"},
+		Type:    onpremise.IssueType{Name: "Task"},
+		Summary: "On-premise",
+	}})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.Key != "EX-1" {
+		t.Errorf("Create() key = %q, want EX-1", created.Key)
+	}
+
+	if _, err := client.Issue.DoTransition(ctx, created.Key, "21"); err != nil {
+		t.Fatalf("DoTransition() error = %v", err)
+	}
+	issue, _, err := client.Issue.Get(ctx, created.ID, nil)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if issue.Fields.Status.Name != "In Progress" {
+		t.Errorf("status = %q, want In Progress", issue.Fields.Status.Name)
+	}
+
+	// The issue is already in progress
+	if _, err := client.Issue.DoTransition(ctx, created.Key, "21"); err == nil {
+		t.Error("DoTransition() to the current status succeeded")
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	server := setupServer(t)
+	client, err := cloud.NewClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	_, resp, err := client.Issue.Get(ctx, "EX-404", nil)
+	var jerr *cloud.Error
+	if !errors.As(err, &jerr) || resp.StatusCode != http.StatusNotFound || len(jerr.ErrorMessages) != 1 {
+		t.Errorf("Get() of unknown issue error = %v", err)
+	}
+
+	req, _ := client.NewRequest(ctx, http.MethodPost, "rest/api/2/issue", map[string]interface{}{
+		"fields": map[string]interface{}{"project": map[string]string{"key": "NOPE"}},
+	})
+	resp, err = client.Do(req, nil)
+	if err = cloud.NewJiraError(resp, err); !errors.As(err, &jerr) || resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Create() of invalid issue error = %v", err)
+	}
+	if jerr.Errors["project"] == "" || jerr.Errors["summary"] == "" {
+		t.Errorf("Create() field errors = %v", jerr.Errors)
+	}
+
+	_, _, err = client.Issue.Search(ctx, "assignee = currentUser()", nil)
+	if !errors.As(err, &jerr) {
+		t.Errorf("Search() with unsupported JQL error = %v", err)
+	}
+}
+
+func TestServer_CloudGroupsAndRoles(t *testing.T) {
+	server := setupServer(t)
+	client, err := cloud.NewClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	var accountIDs []string
+	for _, name := range []string{"alice", "bob", "carol"} {
+		accountIDs = append(accountIDs, server.AddUser(User{Name: name, Active: true}).AccountID)
+	}
+	group, err := server.AddGroup("developers")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, accountID := range accountIDs {
+		if _, _, err := client.Group.AddUserByGroupName(ctx, "developers", accountID); err != nil {
+			t.Fatalf("AddUserByGroupName() error = %v", err)
+		}
+	}
+	members, _, err := client.Group.GetGroupMembers(ctx, group.ID, cloud.WithStartAt(2), cloud.WithMaxResults(2))
+	if err != nil {
+		t.Fatalf("GetGroupMembers() error = %v", err)
+	}
+	if len(members) != 1 || members[0].AccountID != accountIDs[2] {
+		t.Errorf("GetGroupMembers() = %v", members)
+	}
+	if _, err := client.Group.RemoveUserByGroupId(ctx, group.ID, accountIDs[0]); err != nil {
+		t.Fatalf("RemoveUserByGroupId() error = %v", err)
+	}
+	if got := server.GroupMembers("developers"); len(got) != 2 {
+		t.Errorf("GroupMembers() = %v, want 2 members", got)
+	}
+	if _, err := client.Group.RemoveUserByGroupId(ctx, group.ID, accountIDs[0]); err == nil {
+		t.Error("RemoveUserByGroupId() of a non-member succeeded")
+	}
+
+	groups, _, err := client.Group.Find(ctx, cloud.WithGroupNameContains("dev"))
+	if err != nil || len(groups) != 1 || groups[0].ID != group.ID {
+		t.Errorf("Find() = %v, %v", groups, err)
+	}
+
+	if _, err := client.Role.AddUserToRole(ctx, "EX", 10001, accountIDs[1]); err != nil {
+		t.Fatalf("AddUserToRole() error = %v", err)
+	}
+	if err := server.AddRoleActor("EX", 10001, "group", "developers"); err != nil {
+		t.Fatal(err)
+	}
+	actors, _, err := client.Role.GetRoleActorsForProject(ctx, "EX", 10001)
+	if err != nil {
+		t.Fatalf("GetRoleActorsForProject() error = %v", err)
+	}
+	if len(actors) != 2 || actors[0].ActorUser.AccountID != accountIDs[1] || actors[1].ActorGroup.GroupID != group.ID {
+		t.Errorf("GetRoleActorsForProject() = %v", actors)
+	}
+	if _, err := client.Role.RemoveUserFromRole(ctx, "EX", 10001, accountIDs[1]); err != nil {
+		t.Fatalf("RemoveUserFromRole() error = %v", err)
+	}
+	if got := server.RoleActors("EX", 10001); len(got) != 1 || got[0] != "group:developers" {
+		t.Errorf("RoleActors() = %v", got)
+	}
+
+	scheme := server.AddPermissionScheme(PermissionScheme{Name: "Restricted", Permissions: []Permission{
+		{ID: 1, Permission: "BROWSE_PROJECTS", HolderType: "group", HolderParameter: "developers"},
+	}})
+	got, _, err := client.PermissionScheme.Get(ctx, scheme.ID)
+	if err != nil {
+		t.Fatalf("PermissionScheme.Get() error = %v", err)
+	}
+	if got.Name != "Restricted" || len(got.Permissions) != 1 || got.Permissions[0].Holder.Parameter != "developers" {
+		t.Errorf("PermissionScheme.Get() = %+v", got)
+	}
+	projectScheme, _, err := client.Project.GetPermissionScheme(ctx, "EX")
+	if err != nil || projectScheme.ID != DefaultPermissionSchemeID {
+		t.Errorf("Project.GetPermissionScheme() = %v, %v", projectScheme, err)
+	}
+}
+
+func TestServer_OnPremiseUsersAndGroups(t *testing.T) {
+	server := setupServer(t)
+	client, err := onpremise.NewClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	user, _, err := client.User.Create(ctx, &onpremise.User{Name: "dave", EmailAddress: "dave@example.com", DisplayName: "Dave"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := server.AddGroup("jira-users"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Group.Add(ctx, "jira-users", "dave"); err != nil {
+		t.Fatalf("Group.Add() error = %v", err)
+	}
+
+	groups, _, err := client.User.GetGroups(ctx, user.AccountID)
+	if err != nil || len(*groups) != 1 || (*groups)[0].Name != "jira-users" {
+		t.Errorf("GetGroups() = %v, %v", groups, err)
+	}
+	members, _, err := client.Group.Get(ctx, "jira-users", nil)
+	if err != nil || len(members) != 1 || members[0].Name != "dave" {
+		t.Errorf("Group.Get() = %v, %v", members, err)
+	}
+	if _, err := client.Group.Remove(ctx, "jira-users", "dave"); err != nil {
+		t.Fatalf("Group.Remove() error = %v", err)
+	}
+
+	users, _, err := client.User.Find(ctx, "dav")
+	if err != nil || len(users) != 1 || users[0].Key != user.Key {
+		t.Errorf("Find() = %v, %v", users, err)
+	}
+
+	if err := server.SetCurrentUser("dave"); err != nil {
+		t.Fatal(err)
+	}
+	self, _, err := client.User.GetSelf(ctx)
+	if err != nil || self.Name != "dave" {
+		t.Errorf("GetSelf() = %v, %v", self, err)
+	}
+}
+
+func TestServer_InjectFault(t *testing.T) {
+	server := setupServer(t)
+	client, err := cloud.NewClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	server.InjectFault(Fault{Method: http.MethodGet, Path: "/rest/api/2/project", StatusCode: http.StatusTooManyRequests, RetryAfter: 5 * time.Second, Times: 1})
+
+	_, resp, err := client.Project.Get(ctx, "EX")
+	if err == nil || resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("first Get() = %v, %v, want 429", resp, err)
+	}
+	if got := resp.Header.Get("Retry-After"); got != "5" {
+		t.Errorf("Retry-After = %q, want 5", got)
+	}
+	if _, _, err := client.Project.Get(ctx, "EX"); err != nil {
+		t.Errorf("second Get() error = %v, fault should be exhausted", err)
+	}
+
+	server.InjectFault(Fault{Latency: time.Second})
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, _, err := client.Project.Get(ctx, "EX"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() with latency error = %v, want deadline exceeded", err)
+	}
+
+	server.ClearFaults()
+	if _, _, err := client.Project.Get(context.Background(), "EX"); err != nil {
+		t.Errorf("Get() after ClearFaults() error = %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+package jiratest
+
+import "time"
+
+// User is a user of the fake Jira.
+// Users can be addressed by AccountID (Jira Cloud), Name or Key (Jira Server / Data Center).
+type User struct {
+	AccountID    string
+	Name         string
+	Key          string
+	EmailAddress string
+	DisplayName  string
+	Active       bool
+}
+
+// Group is a group of the fake Jira.
+type Group struct {
+	ID   string
+	Name string
+}
+
+// Project is a project of the fake Jira.
+type Project struct {
+	ID   string
+	Key  string
+	Name string
+
+	// PermissionSchemeID is the ID of the permission scheme of the project.
+	// It defaults to the default permission scheme.
+	PermissionSchemeID int
+}
+
+// ServerInfo is the version and deployment reported by the fake Jira, see Server.SetServerInfo.
+type ServerInfo struct {
+	// Version is the version like "9.12.2".
+	Version string
+	// DeploymentType is "Cloud", "Server" or "DataCenter".
+	DeploymentType string
+	BuildNumber    int
+}
+
+// Status is an issue status of the workflow.
+type Status struct {
+	ID   string
+	Name string
+}
+
+// Transition is a transition of the workflow.
+// All transitions are available from every status but their target status.
+type Transition struct {
+	ID   string
+	Name string
+
+	// To is the ID of the target status.
+	To string
+}
+
+// Issue is a snapshot of an issue of the fake Jira.
+type Issue struct {
+	ID         string
+	Key        string
+	ProjectKey string
+	StatusID   string
+
+	// Fields are the fields as sent by the client, like "summary" or "customfield_10001".
+	Fields map[string]interface{}
+
+	Comments []Comment
+}
+
+// Comment is a comment of an issue.
+type Comment struct {
+	ID      string
+	Body    string
+	Author  string
+	Created time.Time
+	Updated time.Time
+}
+
+// Role is a project role.
+type Role struct {
+	ID          int
+	Name        string
+	Description string
+}
+
+// PermissionScheme is a permission scheme.
+type PermissionScheme struct {
+	ID          int
+	Name        string
+	Description string
+	Permissions []Permission
+}
+
+// Permission is a permission grant of a permission scheme.
+type Permission struct {
+	ID         int
+	Permission string
+
+	// HolderType is the type of the holder, like "group", "projectRole" or "anyone".
+	HolderType      string
+	HolderParameter string
+}
+
+// Fault makes the fake Jira misbehave, see Server.InjectFault.
+type Fault struct {
+	// Method is the request method to match. If empty, all methods match.
+	Method string
+
+	// Path is the prefix of the request paths to match, like "/rest/api/2/search".
+	// If empty, all paths match.
+	Path string
+
+	// StatusCode is the status code of the response. If zero, the request is handled normally after Latency.
+	StatusCode int
+
+	// RetryAfter is sent in the Retry-After header, e.g. for 429 Too Many Requests responses.
+	RetryAfter time.Duration
+
+	// Latency delays the response.
+	Latency time.Duration
+
+	// Times is the number of requests the fault applies to. If zero, it applies until the faults are cleared.
+	Times int
+}
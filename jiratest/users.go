@@ -0,0 +1,178 @@
+package jiratest
+
+import (
+	"net/http"
+	"strings"
+)
+
+// findUser returns the user with the given account ID, name or key.
+func (s *Server) findUser(id string) *User {
+	if id == "" {
+		return nil
+	}
+	for _, u := range s.users {
+		if u.AccountID == id || u.Name == id || u.Key == id {
+			return u
+		}
+	}
+	return nil
+}
+
+// userParam returns the user addressed by the accountId, username or key query parameter.
+func (s *Server) userParam(r *http.Request) *User {
+	q := r.URL.Query()
+	for _, param := range []string{"accountId", "username", "key"} {
+		if id := q.Get(param); id != "" {
+			return s.findUser(id)
+		}
+	}
+	return nil
+}
+
+func (s *Server) userJSON(u *User) map[string]interface{} {
+	if u == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"self":         s.self("user?accountId=%s", u.AccountID),
+		"accountId":    u.AccountID,
+		"accountType":  "atlassian",
+		"name":         u.Name,
+		"key":          u.Key,
+		"emailAddress": u.EmailAddress,
+		"displayName":  u.DisplayName,
+		"active":       u.Active,
+		"timeZone":     "UTC",
+	}
+}
+
+func (s *Server) getMyself(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.userJSON(s.findUser(s.currentUser)))
+}
+
+func (s *Server) routeUser(w http.ResponseWriter, r *http.Request, resource []string) {
+	switch {
+	case len(resource) == 0:
+		switch r.Method {
+		case http.MethodGet:
+			u := s.userParam(r)
+			if u == nil {
+				writeError(w, http.StatusNotFound, "The user does not exist.")
+				return
+			}
+			writeJSON(w, http.StatusOK, s.userJSON(u))
+		case http.MethodPost:
+			s.createUser(w, r)
+		case http.MethodDelete:
+			u := s.userParam(r)
+			if u == nil {
+				writeError(w, http.StatusNotFound, "The user does not exist.")
+				return
+			}
+			s.deleteUser(u)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+	case resource[0] == "groups" && r.Method == http.MethodGet:
+		u := s.userParam(r)
+		if u == nil {
+			writeError(w, http.StatusNotFound, "The user does not exist.")
+			return
+		}
+		groups := []interface{}{}
+		for _, g := range s.groups {
+			if g.has(u.AccountID) {
+				groups = append(groups, map[string]string{"name": g.Name, "self": s.self("group?groupname=%s", g.Name)})
+			}
+		}
+		writeJSON(w, http.StatusOK, groups)
+	case resource[0] == "search" && r.Method == http.MethodGet:
+		s.searchUsers(w, r)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name         string `json:"name"`
+		Key          string `json:"key"`
+		EmailAddress string `json:"emailAddress"`
+		DisplayName  string `json:"displayName"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body.EmailAddress == "" {
+		writeFieldErrors(w, map[string]string{"emailAddress": "You must specify an email address."})
+		return
+	}
+	for _, u := range s.users {
+		if (body.Name != "" && u.Name == body.Name) || strings.EqualFold(u.EmailAddress, body.EmailAddress) {
+			writeFieldErrors(w, map[string]string{"username": "A user with that username already exists."})
+			return
+		}
+	}
+	u := s.addUser(User{Name: body.Name, Key: body.Key, EmailAddress: body.EmailAddress, DisplayName: body.DisplayName, Active: true})
+	writeJSON(w, http.StatusCreated, s.userJSON(u))
+}
+
+func (s *Server) deleteUser(u *User) {
+	for i, other := range s.users {
+		if other == u {
+			s.users = append(s.users[:i], s.users[i+1:]...)
+			break
+		}
+	}
+	for _, g := range s.groups {
+		g.remove(u.AccountID)
+	}
+	for key, actors := range s.roleActors {
+		s.roleActors[key] = removeActor(actors, roleActor{typ: "user", name: u.AccountID})
+	}
+}
+
+// searchUsers matches the query, username or accountId parameter against the name, display name and email
+// address of the users.
+func (s *Server) searchUsers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	query := strings.ToLower(q.Get("query"))
+	if query == "" {
+		query = strings.ToLower(q.Get("username"))
+	}
+	accountID := q.Get("accountId")
+	if query == "" && accountID == "" {
+		writeError(w, http.StatusBadRequest, "The query parameter 'query' is required.")
+		return
+	}
+	includeInactive := q.Get("includeInactive") == "true"
+
+	var matches []*User
+	for _, u := range s.users {
+		if !u.Active && !includeInactive {
+			continue
+		}
+		if accountID != "" && u.AccountID != accountID {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(u.Name), query) &&
+			!strings.Contains(strings.ToLower(u.DisplayName), query) &&
+			!strings.Contains(strings.ToLower(u.EmailAddress), query) {
+			continue
+		}
+		matches = append(matches, u)
+	}
+
+	startAt, maxResults := page(r, 50)
+	from, to := bounds(startAt, maxResults, len(matches))
+	users := make([]interface{}, 0, to-from)
+	for _, u := range matches[from:to] {
+		users = append(users, s.userJSON(u))
+	}
+	writeJSON(w, http.StatusOK, users)
+}
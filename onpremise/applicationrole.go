@@ -0,0 +1,161 @@
+package onpremise
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// ApplicationRoleService handles the application roles for the Jira instance / API.
+// Application roles are the applications of the instance, like Jira Software, with their groups and license seats.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/applicationrole
+type ApplicationRoleService service
+
+// applicationRoleGroupPageSize is the page size used to list the members of application role groups.
+const applicationRoleGroupPageSize = 50
+
+// ApplicationRole is an application of the instance with its groups and license seats.
+type ApplicationRole struct {
+	Key                  string   `json:"key"`
+	Groups               []string `json:"groups"`
+	Name                 string   `json:"name"`
+	DefaultGroups        []string `json:"defaultGroups"`
+	SelectedByDefault    bool     `json:"selectedByDefault"`
+	Defined              bool     `json:"defined"`
+	NumberOfSeats        int      `json:"numberOfSeats"`
+	RemainingSeats       int      `json:"remainingSeats"`
+	UserCount            int      `json:"userCount"`
+	UserCountDescription string   `json:"userCountDescription"`
+	HasUnlimitedSeats    bool     `json:"hasUnlimitedSeats"`
+	Platform             bool     `json:"platform"`
+}
+
+// LicenseUtilization is the license usage of an application role.
+type LicenseUtilization struct {
+	Key  string
+	Name string
+
+	// NumberOfSeats, RemainingSeats, UserCount and HasUnlimitedSeats are reported by Jira.
+	NumberOfSeats     int
+	RemainingSeats    int
+	UserCount         int
+	HasUnlimitedSeats bool
+
+	// Users are the user names of the active users in the groups of the role.
+	Users []string
+}
+
+// BillableUsers returns the number of active users in the groups of the role.
+func (u *LicenseUtilization) BillableUsers() int {
+	return len(u.Users)
+}
+
+// Utilization returns the ratio of billable users to seats, or 0 if the seats are unlimited.
+func (u *LicenseUtilization) Utilization() float64 {
+	if u.HasUnlimitedSeats || u.NumberOfSeats == 0 {
+		return 0
+	}
+	return float64(len(u.Users)) / float64(u.NumberOfSeats)
+}
+
+// GetList returns all application roles with their groups and seats.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/applicationrole-getAll
+func (s *ApplicationRoleService) GetList(ctx context.Context) ([]ApplicationRole, *Response, error) {
+	apiEndpoint := "rest/api/2/applicationrole"
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var roles []ApplicationRole
+	resp, err := s.client.Do(req, &roles)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return roles, resp, nil
+}
+
+// Get returns the application role with the given key, like "jira-software".
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/applicationrole-get
+func (s *ApplicationRoleService) Get(ctx context.Context, key string) (*ApplicationRole, *Response, error) {
+	apiEndpoint := "rest/api/2/applicationrole/" + url.PathEscape(key)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(ApplicationRole)
+	resp, err := s.client.Do(req, role)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return role, resp, nil
+}
+
+// LicenseUtilization returns the license usage of all application roles.
+//
+// The billable users of a role are counted by listing the active members of its groups with the GroupService.
+// Users in several groups of a role are counted once.
+// This takes one request per page of 50 members of each group, so it is slow on large instances.
+// The returned Response is the one of the last request.
+func (s *ApplicationRoleService) LicenseUtilization(ctx context.Context) ([]LicenseUtilization, *Response, error) {
+	roles, resp, err := s.GetList(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// Groups often belong to several roles, so their members are listed once
+	members := make(map[string][]string)
+	result := make([]LicenseUtilization, 0, len(roles))
+	for _, role := range roles {
+		utilization := LicenseUtilization{
+			Key:               role.Key,
+			Name:              role.Name,
+			NumberOfSeats:     role.NumberOfSeats,
+			RemainingSeats:    role.RemainingSeats,
+			UserCount:         role.UserCount,
+			HasUnlimitedSeats: role.HasUnlimitedSeats,
+		}
+		seen := make(map[string]bool)
+		for _, group := range role.Groups {
+			names, ok := members[group]
+			if !ok {
+				names, resp, err = s.billableGroupMembers(ctx, group)
+				if err != nil {
+					return nil, resp, err
+				}
+				members[group] = names
+			}
+			for _, name := range names {
+				if !seen[name] {
+					seen[name] = true
+					utilization.Users = append(utilization.Users, name)
+				}
+			}
+		}
+		result = append(result, utilization)
+	}
+	return result, resp, nil
+}
+
+// billableGroupMembers returns the user names of the active members of the group.
+func (s *ApplicationRoleService) billableGroupMembers(ctx context.Context, group string) ([]string, *Response, error) {
+	var names []string
+	for startAt := 0; ; startAt += applicationRoleGroupPageSize {
+		members, resp, err := s.client.Group.Get(ctx, group, &GroupSearchOptions{StartAt: startAt, MaxResults: applicationRoleGroupPageSize})
+		if err != nil {
+			return nil, resp, NewJiraError(resp, err)
+		}
+		for _, m := range members {
+			if m.Active {
+				names = append(names, m.Name)
+			}
+		}
+		if len(members) < applicationRoleGroupPageSize {
+			return names, resp, nil
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestApplicationRoleService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/applicationrole", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/applicationrole")
+		fmt.Fprint(w, `[{"key":"jira-software","groups":["jira-software-users"],"name":"Jira Software","defaultGroups":["jira-software-users"],
+			"selectedByDefault":false,"defined":true,"numberOfSeats":10,"remainingSeats":5,"userCount":5,"userCountDescription":"users",
+			"hasUnlimitedSeats":false,"platform":false}]`)
+	})
+
+	roles, _, err := testClient.ApplicationRole.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(roles) != 1 {
+		t.Fatalf("expected 1 role, got %d", len(roles))
+	}
+	role := roles[0]
+	if role.Key != "jira-software" || role.NumberOfSeats != 10 || role.RemainingSeats != 5 || !reflect.DeepEqual(role.Groups, []string{"jira-software-users"}) {
+		t.Errorf("unexpected role %+v", role)
+	}
+}
+
+func TestApplicationRoleService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/applicationrole/jira-software", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"key":"jira-software","name":"Jira Software","hasUnlimitedSeats":true}`)
+	})
+
+	role, _, err := testClient.ApplicationRole.Get(context.Background(), "jira-software")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if role.Name != "Jira Software" || !role.HasUnlimitedSeats {
+		t.Errorf("unexpected role %+v", role)
+	}
+}
+
+func TestApplicationRoleService_LicenseUtilization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/applicationrole", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"key":"jira-software","name":"Jira Software","numberOfSeats":4,"remainingSeats":1,"userCount":3,"groups":["developers","admins"]},
+			{"key":"jira-servicedesk","name":"Jira Service Desk","hasUnlimitedSeats":true,"groups":["admins"]}]`)
+	})
+	groupRequests := map[string]int{}
+	testMux.HandleFunc("/rest/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		group := r.URL.Query().Get("groupname")
+		groupRequests[group]++
+		switch group {
+		case "developers":
+			fmt.Fprint(w, `{"values":[{"name":"alice","active":true},{"name":"carol","active":false}]}`)
+		case "admins":
+			fmt.Fprint(w, `{"values":[{"name":"alice","active":true},{"name":"bob","active":true}]}`)
+		default:
+			t.Errorf("unexpected group %q", group)
+		}
+	})
+
+	report, _, err := testClient.ApplicationRole.LicenseUtilization(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(report) != 2 {
+		t.Fatalf("expected 2 roles, got %d", len(report))
+	}
+	software := report[0]
+	if !reflect.DeepEqual(software.Users, []string{"alice", "bob"}) || software.BillableUsers() != 2 || software.Utilization() != 0.5 {
+		t.Errorf("unexpected utilization %+v", software)
+	}
+	serviceDesk := report[1]
+	if serviceDesk.BillableUsers() != 2 || serviceDesk.Utilization() != 0 {
+		t.Errorf("unexpected utilization %+v", serviceDesk)
+	}
+	if groupRequests["admins"] != 1 {
+		t.Errorf("expected the members of a group to be listed once, got %d requests", groupRequests["admins"])
+	}
+}
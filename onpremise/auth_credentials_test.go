@@ -0,0 +1,195 @@
+package onpremise
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBasicAuthTransport_Credentials(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var current atomic.Value
+	current.Store("old-token")
+	var fetches int32
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		if _, p, _ := r.BasicAuth(); p != current.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "Rotated") {
+			t.Errorf("Request body not replayed: %q", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"key":"TEST-1"}`))
+	})
+
+	tp := &BasicAuthTransport{
+		Username: "username",
+		Credentials: CredentialFunc(func(ctx context.Context) (*Credential, error) {
+			n := atomic.AddInt32(&fetches, 1)
+			if n == 1 {
+				return &Credential{Secret: "old-token"}, nil
+			}
+			return &Credential{Secret: "new-token"}, nil
+		}),
+	}
+	client, _ := NewClient(testServer.URL, tp.Client())
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Summary: "Rotated"}}); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+	if fetches != 1 {
+		t.Errorf("Expected the credential to be cached. Got %d fetches", fetches)
+	}
+
+	// Rotate the token on the server
+	current.Store("new-token")
+	if _, _, err := client.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Summary: "Rotated"}}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fetches != 2 {
+		t.Errorf("Expected the credential to be fetched again after 401. Got %d fetches", fetches)
+	}
+}
+
+func TestBasicAuthTransport_CredentialsUnchanged(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int32
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	tp := &BasicAuthTransport{Credentials: &EnvCredentials{UsernameVar: "JIRA_TEST_USER", SecretVar: "JIRA_TEST_TOKEN"}}
+	t.Setenv("JIRA_TEST_USER", "username")
+	t.Setenv("JIRA_TEST_TOKEN", "token")
+	client, _ := NewClient(testServer.URL, tp.Client())
+
+	req, _ := client.NewRequest(context.Background(), http.MethodGet, "rest/api/2/myself", nil)
+	if resp, _ := client.Do(req, nil); resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected 401 response. Got %v", resp)
+	}
+	if requests != 1 {
+		t.Errorf("Expected no retry with the same credential. Got %d requests", requests)
+	}
+}
+
+func TestBasicAuthTransport_CredentialsError(t *testing.T) {
+	tp := &BasicAuthTransport{Credentials: &EnvCredentials{SecretVar: "JIRA_TEST_UNSET"}}
+	req, _ := http.NewRequest(http.MethodGet, "https://jira.example.com/rest/api/2/myself", nil)
+	if _, err := tp.RoundTrip(req); !errors.Is(err, ErrCredentialNotFound) {
+		t.Errorf("Expected ErrCredentialNotFound. Got %v", err)
+	}
+}
+
+func TestJWTAuthTransport_Credentials(t *testing.T) {
+	tp := &JWTAuthTransport{
+		Issuer:      "issuer",
+		Credentials: CredentialFunc(func(ctx context.Context) (*Credential, error) { return &Credential{Secret: "secret"}, nil }),
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if !strings.HasPrefix(req.Header.Get("Authorization"), "JWT ") {
+				t.Errorf("Expected JWT authorization. Got %q", req.Header.Get("Authorization"))
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://jira.example.com/rest/api/2/myself", nil)
+	if _, err := tp.RoundTrip(req); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFileCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	os.WriteFile(path, []byte("  secret\n"), 0o600)
+
+	src := &FileCredentials{Username: "username", Path: path, MaxAge: time.Minute}
+	cred, err := src.Credential(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if cred.Username != "username" || cred.Secret != "secret" || cred.Expiry.IsZero() {
+		t.Errorf("Unexpected credential: %+v", cred)
+	}
+
+	src.Path = filepath.Join(t.TempDir(), "missing")
+	if _, err := src.Credential(context.Background()); !errors.Is(err, ErrCredentialNotFound) {
+		t.Errorf("Expected ErrCredentialNotFound. Got %v", err)
+	}
+}
+
+func TestNetrcCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".netrc")
+	os.WriteFile(path, []byte(`machine other.example.com login other password other-secret
+macdef init
+machine jira.example.com login macro password macro
+
+machine jira.example.com
+	login user@example.com
+	password api-token
+default login anonymous password anonymous-secret
+`), 0o600)
+
+	cred, err := (&NetrcCredentials{Machine: "jira.example.com", Path: path}).Credential(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if cred.Username != "user@example.com" || cred.Secret != "api-token" {
+		t.Errorf("Unexpected credential: %+v", cred)
+	}
+
+	cred, err = (&NetrcCredentials{Machine: "unknown.example.com", Path: path}).Credential(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if cred.Username != "anonymous" {
+		t.Errorf("Expected default entry. Got %+v", cred)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestPATAuthTransport_Credentials(t *testing.T) {
+	tokens := []string{"old-token", "new-token"}
+	var fetches int32
+
+	tp := &PATAuthTransport{
+		Credentials: CredentialFunc(func(ctx context.Context) (*Credential, error) {
+			n := atomic.AddInt32(&fetches, 1)
+			return &Credential{Secret: tokens[n-1]}, nil
+		}),
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "Bearer new-token" {
+				return &http.Response{StatusCode: http.StatusUnauthorized, Body: http.NoBody}, nil
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://jira.example.com/rest/api/2/myself", nil)
+	resp, err := tp.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK || fetches != 2 {
+		t.Errorf("Expected retry with the rotated token. Got status %d after %d fetches", resp.StatusCode, fetches)
+	}
+}
@@ -8,14 +8,31 @@ type BasicAuthTransport struct {
 	Username string
 	Password string
 
+	// Credentials provides the username and password. Optional.
+	// If set, it is used instead of Username and Password.
+	Credentials CredentialSource
+
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
+
+	cache credentialCache
 }
 
 // RoundTrip implements the RoundTripper interface.  We just add the
 // basic auth and return the RoundTripper for this transport type.
 func (t *BasicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Credentials != nil {
+		return roundTripWithCredentials(req, t.Credentials, &t.cache, t.transport(), func(req *http.Request, cred *Credential) error {
+			username := cred.Username
+			if username == "" {
+				username = t.Username
+			}
+			req.SetBasicAuth(username, cred.Secret)
+			return nil
+		})
+	}
+
 	req2 := cloneRequest(req) // per RoundTripper contract
 
 	req2.SetBasicAuth(t.Username, t.Password)
@@ -10,14 +10,27 @@ import (
 type BearerAuthTransport struct {
 	Token string
 
+	// Credentials provides the token. Optional.
+	// If set, it is used instead of Token.
+	Credentials CredentialSource
+
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
+
+	cache credentialCache
 }
 
 // RoundTrip implements the RoundTripper interface.  We just add the
 // bearer token and return the RoundTripper for this transport type.
 func (t *BearerAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Credentials != nil {
+		return roundTripWithCredentials(req, t.Credentials, &t.cache, t.transport(), func(req *http.Request, cred *Credential) error {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", cred.Secret))
+			return nil
+		})
+	}
+
 	req2 := cloneRequest(req) // per RoundTripper contract
 
 	req2.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.Token))
@@ -2,15 +2,75 @@ package onpremise
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 )
 
+var (
+	// ErrLoginFailed is matched by every *LoginError.
+	ErrLoginFailed = errors.New("cookieauth: login failed")
+
+	// ErrCaptchaRequired is matched by a *LoginError if Jira requires the user
+	// to solve a CAPTCHA in the web UI after too many failed logins.
+	// Logging in via the API is not possible until then.
+	ErrCaptchaRequired = errors.New("cookieauth: CAPTCHA challenge required")
+)
+
+// LoginError is returned by the CookieAuthTransport if Jira rejects the login.
+type LoginError struct {
+	StatusCode int
+
+	// LoginReason is the value of the X-Seraph-LoginReason header, like "AUTHENTICATED_FAILED".
+	LoginReason string
+
+	// DeniedReason is the value of the X-Authentication-Denied-Reason header,
+	// like "CAPTCHA_CHALLENGE; login-url=https://jira.example.com/login.jsp".
+	DeniedReason string
+
+	// ErrorMessages are the error messages of the response body, if any.
+	ErrorMessages []string
+}
+
+// Error implements the error interface.
+func (e *LoginError) Error() string {
+	msg := fmt.Sprintf("cookieauth: login failed with status code %d", e.StatusCode)
+	if e.DeniedReason != "" {
+		msg += ": " + e.DeniedReason
+	} else if e.LoginReason != "" {
+		msg += ": " + e.LoginReason
+	}
+	if len(e.ErrorMessages) > 0 {
+		msg += ": " + strings.Join(e.ErrorMessages, ", ")
+	}
+	return msg
+}
+
+// Is supports errors.Is with ErrLoginFailed and ErrCaptchaRequired.
+func (e *LoginError) Is(target error) bool {
+	switch target {
+	case ErrLoginFailed:
+		return true
+	case ErrCaptchaRequired:
+		return strings.Contains(e.DeniedReason, "CAPTCHA_CHALLENGE")
+	}
+	return false
+}
+
 // CookieAuthTransport is an http.RoundTripper that authenticates all requests
 // using Jira's cookie-based authentication.
 //
+// The transport logs in on the first request. If the session expires
+// (Jira answers with 401 Unauthorized or redirects to the login page),
+// it logs in again and replays the request once.
+// It is safe for concurrent use.
+//
 // Note that it is generally preferable to use HTTP BASIC authentication with the REST API.
 // However, this resource may be used to mimic the behaviour of Jira's log-in page (e.g. to display log-in errors to a user).
 //
@@ -27,25 +87,41 @@ type CookieAuthTransport struct {
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
+
+	// mu protects SessionObject
+	mu sync.Mutex
 }
 
 // RoundTrip adds the session object to the request.
 func (t *CookieAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	if t.SessionObject == nil {
-		err := t.setSessionObject()
-		if err != nil {
-			return nil, fmt.Errorf("cookieauth: no session object has been set: %w", err)
-		}
+	session, err := t.session(req.Context(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("cookieauth: no session object has been set: %w", err)
 	}
 
-	req2 := cloneRequest(req) // per RoundTripper contract
-	for _, cookie := range t.SessionObject {
-		// Don't add an empty value cookie to the request
-		if cookie.Value != "" {
-			req2.AddCookie(cookie)
-		}
+	resp, err := t.transport().RoundTrip(withSession(req, session))
+	if err != nil || !sessionExpired(resp) {
+		return resp, err
+	}
+
+	// The body of the original request is consumed, the request can only be replayed if it can be rewound
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
 	}
 
+	session, err = t.session(req.Context(), session)
+	if err != nil {
+		resp.Body.Close()
+		return nil, fmt.Errorf("cookieauth: session renewal failed: %w", err)
+	}
+	resp.Body.Close()
+
+	req2 := withSession(req, session)
+	if req.GetBody != nil {
+		if req2.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
 	return t.transport().RoundTrip(req2)
 }
 
@@ -55,16 +131,34 @@ func (t *CookieAuthTransport) Client() *http.Client {
 	return &http.Client{Transport: t}
 }
 
+// session returns the current session cookies, logging in if there are none.
+// If expired is set, these cookies were rejected by Jira: a new session is created,
+// unless another request already renewed it.
+func (t *CookieAuthTransport) session(ctx context.Context, expired []*http.Cookie) ([]*http.Cookie, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.SessionObject != nil && (expired == nil || !sameCookies(t.SessionObject, expired)) {
+		return t.SessionObject, nil
+	}
+
+	if err := t.setSessionObject(ctx); err != nil {
+		return nil, err
+	}
+	return t.SessionObject, nil
+}
+
 // setSessionObject attempts to authenticate the user and set
 // the session object (e.g. cookie)
-func (t *CookieAuthTransport) setSessionObject() error {
-	req, err := t.buildAuthRequest()
+func (t *CookieAuthTransport) setSessionObject(ctx context.Context) error {
+	req, err := t.buildAuthRequest(ctx)
 	if err != nil {
 		return err
 	}
 
 	var authClient = &http.Client{
-		Timeout: time.Second * 60,
+		Transport: t.transport(),
+		Timeout:   time.Second * 60,
 	}
 	resp, err := authClient.Do(req)
 	if err != nil {
@@ -72,12 +166,27 @@ func (t *CookieAuthTransport) setSessionObject() error {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		lerr := &LoginError{
+			StatusCode:   resp.StatusCode,
+			LoginReason:  resp.Header.Get("X-Seraph-LoginReason"),
+			DeniedReason: resp.Header.Get("X-Authentication-Denied-Reason"),
+		}
+		var body struct {
+			ErrorMessages []string `json:"errorMessages"`
+		}
+		if b, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10)); err == nil && json.Unmarshal(b, &body) == nil {
+			lerr.ErrorMessages = body.ErrorMessages
+		}
+		return lerr
+	}
+
 	t.SessionObject = resp.Cookies()
 	return nil
 }
 
 // getAuthRequest assembles the request to get the authenticated cookie
-func (t *CookieAuthTransport) buildAuthRequest() (*http.Request, error) {
+func (t *CookieAuthTransport) buildAuthRequest(ctx context.Context) (*http.Request, error) {
 	body := struct {
 		Username string `json:"username"`
 		Password string `json:"password"`
@@ -89,8 +198,7 @@ func (t *CookieAuthTransport) buildAuthRequest() (*http.Request, error) {
 	b := new(bytes.Buffer)
 	json.NewEncoder(b).Encode(body)
 
-	// TODO Use a context here
-	req, err := http.NewRequest(http.MethodPost, t.AuthURL, b)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.AuthURL, b)
 	if err != nil {
 		return nil, err
 	}
@@ -105,3 +213,40 @@ func (t *CookieAuthTransport) transport() http.RoundTripper {
 	}
 	return http.DefaultTransport
 }
+
+// withSession returns a clone of req carrying the session cookies.
+func withSession(req *http.Request, session []*http.Cookie) *http.Request {
+	req2 := cloneRequest(req) // per RoundTripper contract
+	for _, cookie := range session {
+		// Don't add an empty value cookie to the request
+		if cookie.Value != "" {
+			req2.AddCookie(cookie)
+		}
+	}
+	return req2
+}
+
+// sessionExpired reports whether resp signals that the session is not valid (anymore).
+// Jira answers API requests with 401 Unauthorized and redirects requests for web resources to the login page.
+func sessionExpired(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+	if resp.StatusCode >= 300 && resp.StatusCode <= 399 {
+		return strings.Contains(resp.Header.Get("Location"), "login.jsp")
+	}
+	return false
+}
+
+// sameCookies reports whether a and b are the same session cookies.
+func sameCookies(a, b []*http.Cookie) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Value != b[i].Value {
+			return false
+		}
+	}
+	return true
+}
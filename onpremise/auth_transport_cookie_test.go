@@ -2,8 +2,14 @@ package onpremise
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 )
 
@@ -118,3 +124,178 @@ func TestCookieAuthTransport_SessionObject_DoesNotExist(t *testing.T) {
 	req, _ := basicAuthClient.NewRequest(context.Background(), http.MethodGet, ".", nil)
 	basicAuthClient.Do(req, nil)
 }
+
+// setupSessionServer registers a login endpoint on testMux which issues a new session cookie on every login.
+// Requests to /rest/api/2/issue are only accepted with the latest session.
+func setupSessionServer(t *testing.T) (logins *int32) {
+	logins = new(int32)
+	var mu sync.Mutex
+	current := ""
+
+	testMux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		n := atomic.AddInt32(logins, 1)
+
+		mu.Lock()
+		current = fmt.Sprintf("session-%d", n)
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: current})
+		mu.Unlock()
+
+		fmt.Fprint(w, `{"session":{"name":"JSESSIONID","value":"x"},"loginInfo":{"failedLoginCount":0,"loginCount":1}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		valid := current
+		mu.Unlock()
+
+		cookie, err := r.Cookie("JSESSIONID")
+		if err != nil || cookie.Value != valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "Renewed") {
+			t.Errorf("Request body not replayed: %q", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"key":"TEST-1"}`)
+	})
+	return logins
+}
+
+func TestCookieAuthTransport_RenewsExpiredSession(t *testing.T) {
+	setup()
+	defer teardown()
+	logins := setupSessionServer(t)
+
+	tp := &CookieAuthTransport{
+		Username:      "username",
+		Password:      "password",
+		AuthURL:       testServer.URL + "/rest/auth/1/session",
+		SessionObject: []*http.Cookie{{Name: "JSESSIONID", Value: "expired"}},
+	}
+	client, _ := NewClient(testServer.URL, tp.Client())
+
+	issue, _, err := client.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Summary: "Renewed"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "TEST-1" {
+		t.Errorf("Unexpected issue: %+v", issue)
+	}
+	if *logins != 1 {
+		t.Errorf("Expected 1 login. Got %d", *logins)
+	}
+}
+
+func TestCookieAuthTransport_ConcurrentRenewal(t *testing.T) {
+	setup()
+	defer teardown()
+	logins := setupSessionServer(t)
+
+	tp := &CookieAuthTransport{
+		Username:      "username",
+		Password:      "password",
+		AuthURL:       testServer.URL + "/rest/auth/1/session",
+		SessionObject: []*http.Cookie{{Name: "JSESSIONID", Value: "expired"}},
+	}
+	client, _ := NewClient(testServer.URL, tp.Client())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := client.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Summary: "Renewed"}}); err != nil {
+				t.Errorf("Error given: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if *logins != 1 {
+		t.Errorf("Expected the session to be renewed once. Got %d logins", *logins)
+	}
+}
+
+func TestCookieAuthTransport_LoginRedirect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var logins int32
+	testMux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&logins, 1)
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "valid"})
+	})
+	testMux.HandleFunc("/secure/attachment/10000/", func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("JSESSIONID"); err != nil || cookie.Value != "valid" {
+			http.Redirect(w, r, "/login.jsp?os_destination=%2Fsecure%2Fattachment%2F10000%2F", http.StatusFound)
+			return
+		}
+		fmt.Fprint(w, "content")
+	})
+
+	tp := &CookieAuthTransport{
+		AuthURL:       testServer.URL + "/rest/auth/1/session",
+		SessionObject: []*http.Cookie{{Name: "JSESSIONID", Value: "expired"}},
+	}
+	client, _ := NewClient(testServer.URL, tp.Client())
+
+	resp, err := client.Issue.DownloadAttachment(context.Background(), "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != "content" || logins != 1 {
+		t.Errorf("Unexpected content %q after %d logins", body, logins)
+	}
+}
+
+func TestCookieAuthTransport_LoginError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Seraph-LoginReason", "AUTHENTICATION_DENIED")
+		w.Header().Set("X-Authentication-Denied-Reason", "CAPTCHA_CHALLENGE; login-url=https://jira.example.com/login.jsp")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errorMessages":["Login denied"],"errors":{}}`)
+	})
+
+	tp := &CookieAuthTransport{
+		Username: "username",
+		Password: "wrong",
+		AuthURL:  testServer.URL + "/rest/auth/1/session",
+	}
+	client, _ := NewClient(testServer.URL, tp.Client())
+
+	_, _, err := client.User.GetSelf(context.Background())
+	if !errors.Is(err, ErrCaptchaRequired) || !errors.Is(err, ErrLoginFailed) {
+		t.Fatalf("Expected ErrCaptchaRequired. Got %v", err)
+	}
+
+	var lerr *LoginError
+	if !errors.As(err, &lerr) {
+		t.Fatalf("Expected *LoginError. Got %T", err)
+	}
+	if lerr.StatusCode != http.StatusForbidden || lerr.LoginReason != "AUTHENTICATION_DENIED" || len(lerr.ErrorMessages) != 1 {
+		t.Errorf("Unexpected login error: %+v", lerr)
+	}
+	if tp.SessionObject != nil {
+		t.Errorf("No session must be stored after a failed login")
+	}
+}
+
+func TestCookieAuthTransport_LoginUsesContext(t *testing.T) {
+	tp := &CookieAuthTransport{AuthURL: "https://jira.example.com/rest/auth/1/session"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://jira.example.com/rest/api/2/myself", nil)
+	if _, err := tp.RoundTrip(req); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled. Got %v", err)
+	}
+}
@@ -27,9 +27,15 @@ type JWTAuthTransport struct {
 	Secret []byte
 	Issuer string
 
+	// Credentials provides the shared secret and optionally the issuer. Optional.
+	// If set, it is used instead of Secret.
+	Credentials CredentialSource
+
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
+
+	cache credentialCache
 }
 
 func (t *JWTAuthTransport) Client() *http.Client {
@@ -45,23 +51,41 @@ func (t *JWTAuthTransport) transport() http.RoundTripper {
 
 // RoundTrip adds the session object to the request.
 func (t *JWTAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Credentials != nil {
+		return roundTripWithCredentials(req, t.Credentials, &t.cache, t.transport(), func(req *http.Request, cred *Credential) error {
+			issuer := cred.Username
+			if issuer == "" {
+				issuer = t.Issuer
+			}
+			return t.sign(req, issuer, []byte(cred.Secret))
+		})
+	}
+
 	req2 := cloneRequest(req) // per RoundTripper contract
+	if err := t.sign(req2, t.Issuer, t.Secret); err != nil {
+		return nil, err
+	}
+	return t.transport().RoundTrip(req2)
+}
+
+// sign sets the Authorization header of req to a JWT signed with secret.
+func (t *JWTAuthTransport) sign(req *http.Request, issuer string, secret []byte) error {
 	exp := time.Duration(59) * time.Second
-	qsh := t.createQueryStringHash(req.Method, req2.URL)
+	qsh := t.createQueryStringHash(req.Method, req.URL)
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"iss": t.Issuer,
+		"iss": issuer,
 		"iat": time.Now().Unix(),
 		"exp": time.Now().Add(exp).Unix(),
 		"qsh": qsh,
 	})
 
-	jwtStr, err := token.SignedString(t.Secret)
+	jwtStr, err := token.SignedString(secret)
 	if err != nil {
-		return nil, fmt.Errorf("jwtAuth: error signing JWT: %w", err)
+		return fmt.Errorf("jwtAuth: error signing JWT: %w", err)
 	}
 
-	req2.Header.Set("Authorization", fmt.Sprintf("JWT %s", jwtStr))
-	return t.transport().RoundTrip(req2)
+	req.Header.Set("Authorization", fmt.Sprintf("JWT %s", jwtStr))
+	return nil
 }
 
 func (t *JWTAuthTransport) createQueryStringHash(httpMethod string, jiraURL *url.URL) string {
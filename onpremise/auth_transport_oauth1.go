@@ -0,0 +1,376 @@
+package onpremise
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	oauth1RequestTokenPath = "plugins/servlet/oauth/request-token"
+	oauth1AuthorizePath    = "plugins/servlet/oauth/authorize"
+	oauth1AccessTokenPath  = "plugins/servlet/oauth/access-token"
+
+	oauth1SignatureMethod = "RSA-SHA1"
+)
+
+// OAuth1Transport is an http.RoundTripper that authenticates all requests
+// using OAuth 1.0a with RSA-SHA1 signatures, as used by Jira application links.
+//
+// The access token is obtained with the OAuth1Config helpers.
+//
+// Jira docs: https://developer.atlassian.com/server/jira/platform/oauth/
+type OAuth1Transport struct {
+	// ConsumerKey is the consumer key configured in the incoming application link.
+	ConsumerKey string
+
+	// PrivateKey is the key whose public key is configured in the application link.
+	// See ParseOAuth1PrivateKey.
+	PrivateKey *rsa.PrivateKey
+
+	// AccessToken is the token obtained with OAuth1Config.AccessToken.
+	AccessToken string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.  We just add the
+// OAuth 1.0a authorization header and return the RoundTripper for this transport type.
+func (t *OAuth1Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := cloneRequest(req) // per RoundTripper contract
+
+	params := map[string]string{}
+	if t.AccessToken != "" {
+		params["oauth_token"] = t.AccessToken
+	}
+	if err := signOAuth1Request(req2, t.ConsumerKey, t.PrivateKey, params); err != nil {
+		return nil, err
+	}
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using OAuth 1.0a.  This is a nice little bit of sugar
+// so we can just get the client instead of creating the client in the calling code.
+// If it's necessary to send more information on client init, the calling code can
+// always skip this and set the transport itself.
+func (t *OAuth1Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *OAuth1Transport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// OAuth1Config drives the OAuth 1.0a authorization ("dance") against Jira:
+//
+//  1. RequestToken obtains a temporary request token.
+//  2. The user opens AuthorizeURL and approves the access.
+//     Jira redirects to CallbackURL or shows a verification code.
+//  3. AccessToken exchanges the request token and the verification code for an access token.
+//
+// The access token is then used with an OAuth1Transport, see Transport.
+type OAuth1Config struct {
+	// BaseURL is the base URL of the Jira instance, like "https://jira.example.com/".
+	BaseURL string
+
+	// ConsumerKey is the consumer key configured in the incoming application link.
+	ConsumerKey string
+
+	// PrivateKey is the key whose public key is configured in the application link.
+	PrivateKey *rsa.PrivateKey
+
+	// CallbackURL is where Jira redirects the user after authorizing the request token.
+	// It defaults to "oob" (out of band), which displays the verification code to the user instead.
+	CallbackURL string
+
+	// HTTPClient is used for the token requests.
+	// It defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// OAuth1Error is returned if Jira rejects an OAuth 1.0a token request.
+//
+// Jira docs: https://oauth.pbworks.com/w/page/12238543/ProblemReporting
+type OAuth1Error struct {
+	StatusCode int
+	// Problem is the reported oauth_problem, like "consumer_key_unknown" or "token_rejected".
+	Problem string
+	// Body is the raw response body.
+	Body string
+}
+
+// Error implements the error interface.
+func (e *OAuth1Error) Error() string {
+	if e.Problem != "" {
+		return fmt.Sprintf("oauth1: %s (status code %d)", e.Problem, e.StatusCode)
+	}
+	return fmt.Sprintf("oauth1: token request failed with status code %d", e.StatusCode)
+}
+
+// RequestToken obtains a temporary request token.
+// Jira signs with RSA-SHA1 only, so the returned token secret is not needed in later steps.
+func (c *OAuth1Config) RequestToken(ctx context.Context) (token, secret string, err error) {
+	callback := c.CallbackURL
+	if callback == "" {
+		callback = "oob"
+	}
+
+	values, err := c.tokenRequest(ctx, oauth1RequestTokenPath, map[string]string{"oauth_callback": callback})
+	if err != nil {
+		return "", "", err
+	}
+	token = values.Get("oauth_token")
+	if token == "" {
+		return "", "", errors.New("oauth1: response contains no request token")
+	}
+	return token, values.Get("oauth_token_secret"), nil
+}
+
+// AuthorizeURL returns the URL the user has to open to authorize requestToken.
+func (c *OAuth1Config) AuthorizeURL(requestToken string) string {
+	return c.endpoint(oauth1AuthorizePath) + "?" + url.Values{"oauth_token": {requestToken}}.Encode()
+}
+
+// AccessToken exchanges an authorized request token and its verification code for an access token.
+func (c *OAuth1Config) AccessToken(ctx context.Context, requestToken, verifier string) (string, error) {
+	values, err := c.tokenRequest(ctx, oauth1AccessTokenPath, map[string]string{
+		"oauth_token":    requestToken,
+		"oauth_verifier": verifier,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	token := values.Get("oauth_token")
+	if token == "" {
+		return "", errors.New("oauth1: response contains no access token")
+	}
+	return token, nil
+}
+
+// Transport returns an OAuth1Transport authenticating with accessToken.
+func (c *OAuth1Config) Transport(accessToken string) *OAuth1Transport {
+	var transport http.RoundTripper
+	if c.HTTPClient != nil {
+		transport = c.HTTPClient.Transport
+	}
+	return &OAuth1Transport{
+		ConsumerKey: c.ConsumerKey,
+		PrivateKey:  c.PrivateKey,
+		AccessToken: accessToken,
+		Transport:   transport,
+	}
+}
+
+func (c *OAuth1Config) endpoint(path string) string {
+	return strings.TrimSuffix(c.BaseURL, "/") + "/" + path
+}
+
+// tokenRequest sends a signed POST request to a token endpoint and parses the form encoded response.
+func (c *OAuth1Config) tokenRequest(ctx context.Context, path string, params map[string]string) (url.Values, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint(path), nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := signOAuth1Request(req, c.ConsumerKey, c.PrivateKey, params); err != nil {
+		return nil, err
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return nil, err
+	}
+	values, _ := url.ParseQuery(string(body))
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &OAuth1Error{StatusCode: resp.StatusCode, Problem: values.Get("oauth_problem"), Body: string(body)}
+	}
+	return values, nil
+}
+
+// ParseOAuth1PrivateKey parses a PEM encoded RSA private key in PKCS #1 ("RSA PRIVATE KEY")
+// or PKCS #8 ("PRIVATE KEY") form.
+func ParseOAuth1PrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("oauth1: no PEM data found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("oauth1: could not parse private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("oauth1: private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// signOAuth1Request adds the Authorization header with the RSA-SHA1 signature to req.
+// params holds additional protocol parameters like oauth_token.
+func signOAuth1Request(req *http.Request, consumerKey string, key *rsa.PrivateKey, params map[string]string) error {
+	if key == nil {
+		return errors.New("oauth1: no private key configured")
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	oauthParams := map[string]string{
+		"oauth_consumer_key":     consumerKey,
+		"oauth_nonce":            hex.EncodeToString(nonce),
+		"oauth_signature_method": oauth1SignatureMethod,
+		"oauth_timestamp":        strconv.FormatInt(time.Now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	for k, v := range params {
+		oauthParams[k] = v
+	}
+
+	form, err := oauth1FormParams(req)
+	if err != nil {
+		return err
+	}
+
+	h := sha1.Sum([]byte(oauth1SignatureBase(req.Method, req.URL, oauthParams, form)))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA1, h[:])
+	if err != nil {
+		return fmt.Errorf("oauth1: error signing request: %w", err)
+	}
+	oauthParams["oauth_signature"] = base64.StdEncoding.EncodeToString(signature)
+
+	keys := make([]string, 0, len(oauthParams))
+	for k := range oauthParams {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	header := make([]string, 0, len(keys))
+	for _, k := range keys {
+		header = append(header, fmt.Sprintf(`%s="%s"`, oauth1Escape(k), oauth1Escape(oauthParams[k])))
+	}
+	req.Header.Set("Authorization", "OAuth "+strings.Join(header, ", "))
+	return nil
+}
+
+// oauth1FormParams returns the parameters of a form encoded request body.
+// They are part of the signature. The body is read through req.GetBody, which leaves req.Body untouched.
+func oauth1FormParams(req *http.Request) (url.Values, error) {
+	if req.GetBody == nil {
+		return nil, nil
+	}
+	if mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type")); mediaType != "application/x-www-form-urlencoded" {
+		return nil, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(body); err != nil {
+		return nil, err
+	}
+	return url.ParseQuery(buf.String())
+}
+
+// oauth1SignatureBase returns the signature base string of a request.
+//
+// Specification: https://tools.ietf.org/html/rfc5849#section-3.4.1
+func oauth1SignatureBase(method string, u *url.URL, oauthParams map[string]string, form url.Values) string {
+	var pairs [][2]string
+	add := func(k, v string) {
+		pairs = append(pairs, [2]string{oauth1Escape(k), oauth1Escape(v)})
+	}
+	for k, vs := range u.Query() {
+		for _, v := range vs {
+			add(k, v)
+		}
+	}
+	for k, vs := range form {
+		for _, v := range vs {
+			add(k, v)
+		}
+	}
+	for k, v := range oauthParams {
+		if k != "oauth_signature" && k != "realm" {
+			add(k, v)
+		}
+	}
+	// Parameters are sorted by name and, if names are equal, by value
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+	normalized := make([]string, len(pairs))
+	for i, p := range pairs {
+		normalized[i] = p[0] + "=" + p[1]
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Host)
+	if scheme == "http" && strings.HasSuffix(host, ":80") || scheme == "https" && strings.HasSuffix(host, ":443") {
+		host = host[:strings.LastIndex(host, ":")]
+	}
+	baseURL := scheme + "://" + host + u.EscapedPath()
+
+	return strings.ToUpper(method) + "&" + oauth1Escape(baseURL) + "&" + oauth1Escape(strings.Join(normalized, "&"))
+}
+
+// oauth1Escape percent-encodes s as required by the OAuth 1.0 specification:
+// all characters except the unreserved ones (RFC 3986) are encoded.
+func oauth1Escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
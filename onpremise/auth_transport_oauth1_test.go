@@ -0,0 +1,194 @@
+package onpremise
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestOAuth1SignatureBase(t *testing.T) {
+	// Example of https://tools.ietf.org/html/rfc5849#section-3.4.1.1
+	u, _ := url.Parse("http://example.com:80/request?b5=%3D%253D&a3=a&c%40=&a2=r%20b")
+	form, _ := url.ParseQuery("c2&a3=2+q")
+	oauthParams := map[string]string{
+		"oauth_consumer_key":     "9djdj82h48djs9d2",
+		"oauth_token":            "kkk9d7dh3k39sjv7",
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        "137131201",
+		"oauth_nonce":            "7d8f3e4a",
+		"oauth_signature":        "ignored",
+	}
+
+	want := "POST&http%3A%2F%2Fexample.com%2Frequest&a2%3Dr%2520b%26a3%3D2%2520q%26a3%3Da%26b5%3D%253D%25253D%26c%2540%3D%26c2%3D%26oauth_consumer_key%3D9djdj82h48djs9d2%26oauth_nonce%3D7d8f3e4a%26oauth_signature_method%3DHMAC-SHA1%26oauth_timestamp%3D137131201%26oauth_token%3Dkkk9d7dh3k39sjv7"
+	if got := oauth1SignatureBase(http.MethodPost, u, oauthParams, form); got != want {
+		t.Errorf("oauth1SignatureBase() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestOAuth1Escape(t *testing.T) {
+	if got, want := oauth1Escape("Ladies + Gentlemen ~-._ ☃"), "Ladies%20%2B%20Gentlemen%20~-._%20%E2%98%83"; got != want {
+		t.Errorf("oauth1Escape() = %s, want %s", got, want)
+	}
+}
+
+func TestParseOAuth1PrivateKey(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 1024)
+	pkcs8, _ := x509.MarshalPKCS8PrivateKey(key)
+
+	for _, block := range []*pem.Block{
+		{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)},
+		{Type: "PRIVATE KEY", Bytes: pkcs8},
+	} {
+		parsed, err := ParseOAuth1PrivateKey(pem.EncodeToMemory(block))
+		if err != nil {
+			t.Errorf("%s: Error given: %s", block.Type, err)
+			continue
+		}
+		if !parsed.Equal(key) {
+			t.Errorf("%s: Parsed key differs", block.Type)
+		}
+	}
+
+	if _, err := ParseOAuth1PrivateKey([]byte("not a key")); err == nil {
+		t.Error("Expected an error. Got none")
+	}
+}
+
+// verifyOAuth1 checks the OAuth 1.0a signature of a request received by the test server
+// and returns the protocol parameters.
+func verifyOAuth1(t *testing.T, r *http.Request, key *rsa.PublicKey) map[string]string {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "OAuth ") {
+		t.Fatalf("Authorization header: %q", auth)
+	}
+
+	params := map[string]string{}
+	for _, pair := range strings.Split(strings.TrimPrefix(auth, "OAuth "), ", ") {
+		k, v, _ := strings.Cut(pair, "=")
+		v, _ = url.PathUnescape(strings.Trim(v, `"`))
+		params[k] = v
+	}
+	if params["oauth_signature_method"] != "RSA-SHA1" || params["oauth_consumer_key"] != "go-jira" {
+		t.Errorf("Unexpected parameters: %v", params)
+	}
+
+	u, _ := url.Parse(testServer.URL + r.URL.RequestURI())
+	h := sha1.Sum([]byte(oauth1SignatureBase(r.Method, u, params, nil)))
+	signature, _ := base64.StdEncoding.DecodeString(params["oauth_signature"])
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA1, h[:], signature); err != nil {
+		t.Errorf("Invalid signature: %s", err)
+	}
+	return params
+}
+
+func TestOAuth1_EndToEnd(t *testing.T) {
+	setup()
+	defer teardown()
+
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	testMux.HandleFunc("/plugins/servlet/oauth/request-token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		params := verifyOAuth1(t, r, &key.PublicKey)
+		if params["oauth_callback"] != "oob" {
+			t.Errorf("oauth_callback: %q", params["oauth_callback"])
+		}
+		fmt.Fprint(w, "oauth_token=request-token&oauth_token_secret=request-secret&oauth_callback_confirmed=true")
+	})
+	testMux.HandleFunc("/plugins/servlet/oauth/access-token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		params := verifyOAuth1(t, r, &key.PublicKey)
+		if params["oauth_token"] != "request-token" || params["oauth_verifier"] != "verifier" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, "oauth_problem=token_rejected")
+			return
+		}
+		fmt.Fprint(w, "oauth_token=access-token&oauth_token_secret=access-secret&oauth_expires_in=157680000")
+	})
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		params := verifyOAuth1(t, r, &key.PublicKey)
+		if params["oauth_token"] != "access-token" {
+			t.Errorf("oauth_token: %q", params["oauth_token"])
+		}
+		fmt.Fprint(w, `{"name":"fred"}`)
+	})
+
+	config := &OAuth1Config{BaseURL: testServer.URL, ConsumerKey: "go-jira", PrivateKey: key}
+	ctx := context.Background()
+
+	requestToken, secret, err := config.RequestToken(ctx)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if requestToken != "request-token" || secret != "request-secret" {
+		t.Errorf("Unexpected request token %q and secret %q", requestToken, secret)
+	}
+
+	if got, want := config.AuthorizeURL(requestToken), testServer.URL+"/plugins/servlet/oauth/authorize?oauth_token=request-token"; got != want {
+		t.Errorf("AuthorizeURL: %s, want %s", got, want)
+	}
+
+	_, err = config.AccessToken(ctx, requestToken, "wrong")
+	var oerr *OAuth1Error
+	if !errors.As(err, &oerr) || oerr.Problem != "token_rejected" || oerr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected token_rejected OAuth1Error. Got %v", err)
+	}
+
+	accessToken, err := config.AccessToken(ctx, requestToken, "verifier")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if accessToken != "access-token" {
+		t.Errorf("Access token: %q", accessToken)
+	}
+
+	client, _ := NewClient(testServer.URL, config.Transport(accessToken).Client())
+	user, _, err := client.User.GetSelf(ctx)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.Name != "fred" {
+		t.Errorf("Unexpected user: %+v", user)
+	}
+}
+
+func TestOAuth1Transport_QueryAndForm(t *testing.T) {
+	setup()
+	defer teardown()
+
+	key, _ := rsa.GenerateKey(rand.Reader, 1024)
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		params := verifyOAuth1(t, r, &key.PublicKey)
+		if params["oauth_token"] != "access-token" {
+			t.Errorf("oauth_token: %q", params["oauth_token"])
+		}
+	})
+
+	tp := &OAuth1Transport{ConsumerKey: "go-jira", PrivateKey: key, AccessToken: "access-token"}
+	resp, err := tp.Client().Get(testServer.URL + "/rest/api/2/search?jql=project%20%3D%20TEST&maxResults=5")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	resp.Body.Close()
+
+	form, _ := url.ParseQuery("a=1")
+	if got, _ := oauth1FormParams(&http.Request{Header: http.Header{}}); got != nil {
+		t.Errorf("Expected no form parameters without body. Got %v", got)
+	}
+	req, _ := http.NewRequest(http.MethodPost, testServer.URL, strings.NewReader("a=1"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if got, _ := oauth1FormParams(req); got.Encode() != form.Encode() {
+		t.Errorf("Form parameters: %v, want %v", got, form)
+	}
+}
@@ -9,14 +9,27 @@ type PATAuthTransport struct {
 	// Token is the key that was provided by Jira when creating the Personal Access Token.
 	Token string
 
+	// Credentials provides the token. Optional.
+	// If set, it is used instead of Token.
+	Credentials CredentialSource
+
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
+
+	cache credentialCache
 }
 
 // RoundTrip implements the RoundTripper interface.  We just add the
 // basic auth and return the RoundTripper for this transport type.
 func (t *PATAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Credentials != nil {
+		return roundTripWithCredentials(req, t.Credentials, &t.cache, t.transport(), func(req *http.Request, cred *Credential) error {
+			req.Header.Set("Authorization", "Bearer "+cred.Secret)
+			return nil
+		})
+	}
+
 	req2 := cloneRequest(req) // per RoundTripper contract
 	req2.Header.Set("Authorization", "Bearer "+t.Token)
 	return t.transport().RoundTrip(req2)
@@ -76,8 +76,8 @@ func TestAuthenticationService_AcquireSessionCookie_Success(t *testing.T) {
 		t.Error("Expected true, but result was false")
 	}
 
-	if testClient.Authentication.authType != authTypeSession {
-		t.Errorf("Expected authType %d. Got %d", authTypeSession, testClient.Authentication.authType)
+	if testClient.authentication.authType != authTypeSession {
+		t.Errorf("Expected authType %d. Got %d", authTypeSession, testClient.authentication.authType)
 	}
 }
 
@@ -87,16 +87,16 @@ func TestAuthenticationService_SetBasicAuth(t *testing.T) {
 
 	testClient.Authentication.SetBasicAuth("test-user", "test-password")
 
-	if testClient.Authentication.username != "test-user" {
-		t.Errorf("Expected username test-user. Got %s", testClient.Authentication.username)
+	if testClient.authentication.username != "test-user" {
+		t.Errorf("Expected username test-user. Got %s", testClient.authentication.username)
 	}
 
-	if testClient.Authentication.password != "test-password" {
-		t.Errorf("Expected password test-password. Got %s", testClient.Authentication.password)
+	if testClient.authentication.password != "test-password" {
+		t.Errorf("Expected password test-password. Got %s", testClient.authentication.password)
 	}
 
-	if testClient.Authentication.authType != authTypeBasic {
-		t.Errorf("Expected authType %d. Got %d", authTypeBasic, testClient.Authentication.authType)
+	if testClient.authentication.authType != authTypeBasic {
+		t.Errorf("Expected authType %d. Got %d", authTypeBasic, testClient.authentication.authType)
 	}
 }
 
@@ -105,7 +105,7 @@ func TestAuthenticationService_Authenticated(t *testing.T) {
 	testClient = new(Client)
 
 	// Test before we've attempted to authenticate
-	if testClient.Authentication.Authenticated() != false {
+	if testClient.authentication.Authenticated() != false {
 		t.Error("Expected false, but result was true")
 	}
 }
@@ -213,7 +213,7 @@ func TestAuthenticationService_GetUserInfo_FailWithoutLogin(t *testing.T) {
 	// no setup() required here
 	testClient = new(Client)
 
-	_, err := testClient.Authentication.GetCurrentUser(context.Background())
+	_, err := testClient.authentication.GetCurrentUser(context.Background())
 	if err == nil {
 		t.Errorf("Expected error, but got %s", err)
 	}
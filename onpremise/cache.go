@@ -0,0 +1,314 @@
+package onpremise
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheStatusHeader is set on responses served by the ResponseCache.
+// Its value is CacheHit or CacheRevalidated.
+const CacheStatusHeader = "X-Go-Jira-Cache"
+
+// Values of the CacheStatusHeader.
+const (
+	// CacheHit is a response served from the cache without asking Jira.
+	CacheHit = "hit"
+	// CacheRevalidated is a response served from the cache after Jira confirmed it is unchanged (304 Not Modified).
+	CacheRevalidated = "revalidated"
+)
+
+// DefaultCacheTTLs returns the time-to-live of the metadata endpoints which are cached by default.
+// The keys are the endpoint paths relative to the BaseURL of the client.
+func DefaultCacheTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		"rest/api/2/field":         10 * time.Minute,
+		"rest/api/2/priority":      time.Hour,
+		"rest/api/2/resolution":    time.Hour,
+		"rest/api/2/status":        time.Hour,
+		"rest/api/2/issueLinkType": time.Hour,
+		"rest/api/3/role":          time.Hour,
+	}
+}
+
+// CachedResponse is a response stored in a CacheBackend.
+type CachedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+
+	// ETag is the entity tag Jira sent with the response, if any.
+	// It is used to revalidate the response once it expired.
+	ETag string `json:"etag,omitempty"`
+
+	// Expires is the time after which the response must be revalidated.
+	Expires time.Time `json:"expires"`
+}
+
+// CacheBackend stores cached responses.
+// Implementations must be safe for concurrent use.
+type CacheBackend interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, resp *CachedResponse)
+	Delete(key string)
+	Clear()
+}
+
+// ResponseCache caches the responses of GET requests to rarely changing endpoints, like the list of fields.
+// Set it as the Cache of a Client to enable it.
+//
+// Only successful GET requests of endpoints with a TTL are cached. Once a response expired and Jira sent an ETag,
+// the response is revalidated with If-None-Match. Once a request with any other method to a cached endpoint, or to a resource
+// below it like "rest/api/2/issueLinkType/10000", is done, it invalidates the cached response of the endpoint
+// unless Jira rejected it.
+//
+// Responses are cached per URL and not per user. Do not share a ResponseCache between clients
+// authenticated as users which see different data.
+type ResponseCache struct {
+	// Backend stores the responses. It defaults to a MemoryCache with 256 entries.
+	Backend CacheBackend
+
+	// TTLs is the time-to-live per endpoint path, like "rest/api/2/field".
+	// It defaults to DefaultCacheTTLs.
+	TTLs map[string]time.Duration
+
+	once sync.Once
+}
+
+// Invalidate removes the cached responses of the given endpoint paths, like "rest/api/2/field".
+func (rc *ResponseCache) Invalidate(c *Client, paths ...string) {
+	for _, path := range paths {
+		u := c.BaseURL.ResolveReference(&url.URL{Path: strings.TrimLeft(path, "/")})
+		rc.backend().Delete(cacheKey(u))
+	}
+}
+
+// InvalidateAll removes all cached responses.
+func (rc *ResponseCache) InvalidateAll() {
+	rc.backend().Clear()
+}
+
+func (rc *ResponseCache) backend() CacheBackend {
+	rc.once.Do(func() {
+		if rc.Backend == nil {
+			rc.Backend = NewMemoryCache(256)
+		}
+		if rc.TTLs == nil {
+			rc.TTLs = DefaultCacheTTLs()
+		}
+	})
+	return rc.Backend
+}
+
+// do sends req via httpClient, serving it from the cache if possible.
+func (rc *ResponseCache) do(c *Client, httpClient *http.Client, req *http.Request) (*http.Response, error) {
+	backend := rc.backend()
+	path := strings.TrimPrefix(req.URL.Path, c.BaseURL.Path)
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodHead, http.MethodOptions:
+		return httpClient.Do(req)
+	default:
+		// Mutating requests are never cached, but invalidate the cached responses of the endpoint
+		// and of the lists containing the resource, like "rest/api/2/field" for "rest/api/2/field/{id}".
+		// This happens once the request is done, so that GET requests sent in the meantime
+		// can't cache the state before the change.
+		resp, err := httpClient.Do(req)
+		if err == nil && (resp.StatusCode < 200 || resp.StatusCode > 299) {
+			return resp, nil
+		}
+		for ttlPath := range rc.TTLs {
+			if path == ttlPath || strings.HasPrefix(path, ttlPath+"/") {
+				rc.Invalidate(c, ttlPath)
+			}
+		}
+		return resp, err
+	}
+
+	ttl, cacheable := rc.TTLs[path]
+	if !cacheable {
+		return httpClient.Do(req)
+	}
+
+	key := cacheKey(req.URL)
+
+	cached, ok := backend.Get(key)
+	if ok && time.Now().Before(cached.Expires) {
+		return cached.response(req, CacheHit), nil
+	}
+	if ok && cached.ETag != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && ok:
+		resp.Body.Close()
+		cached.Expires = time.Now().Add(ttl)
+		backend.Set(key, cached)
+		return cached.response(req, CacheRevalidated), nil
+	case resp.StatusCode == http.StatusOK:
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		backend.Set(key, &CachedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       body,
+			ETag:       resp.Header.Get("ETag"),
+			Expires:    time.Now().Add(ttl),
+		})
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return resp, nil
+}
+
+// response builds an *http.Response for req from the cached response.
+func (cr *CachedResponse) response(req *http.Request, status string) *http.Response {
+	header := cr.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set(CacheStatusHeader, status)
+	return &http.Response{
+		Status:        http.StatusText(cr.StatusCode),
+		StatusCode:    cr.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(cr.Body)),
+		ContentLength: int64(len(cr.Body)),
+		Request:       req,
+	}
+}
+
+// MemoryCache is an in-memory CacheBackend which evicts the least recently used responses.
+type MemoryCache struct {
+	mu  sync.Mutex
+	lru *lru[*CachedResponse]
+}
+
+// NewMemoryCache returns a MemoryCache holding up to size responses.
+func NewMemoryCache(size int) *MemoryCache {
+	return &MemoryCache{lru: newLRU[*CachedResponse](size)}
+}
+
+// Get implements the CacheBackend interface.
+func (m *MemoryCache) Get(key string) (*CachedResponse, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cached, ok := m.lru.get(key)
+	if !ok {
+		return nil, false
+	}
+	resp := *cached
+	return &resp, true
+}
+
+// Set implements the CacheBackend interface.
+func (m *MemoryCache) Set(key string, resp *CachedResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lru.set(key, resp)
+}
+
+// Delete implements the CacheBackend interface.
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lru.delete(key)
+}
+
+// Clear implements the CacheBackend interface.
+func (m *MemoryCache) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lru.clear()
+}
+
+// DiskCache is a CacheBackend storing responses as JSON files in a directory,
+// so they survive restarts of the process.
+type DiskCache struct {
+	Dir string
+}
+
+// Get implements the CacheBackend interface.
+func (d *DiskCache) Get(key string) (*CachedResponse, bool) {
+	b, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+	resp := new(CachedResponse)
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, false
+	}
+	return resp, true
+}
+
+// Set implements the CacheBackend interface.
+// Errors are ignored, the response is just not cached.
+func (d *DiskCache) Set(key string, resp *CachedResponse) {
+	b, err := json.Marshal(resp)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(d.Dir, 0o700); err != nil {
+		return
+	}
+	// Write to a temporary file first, so concurrent readers never see a partial file
+	tmp, err := os.CreateTemp(d.Dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(b)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), d.path(key))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// Delete implements the CacheBackend interface.
+func (d *DiskCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
+
+// Clear implements the CacheBackend interface.
+func (d *DiskCache) Clear() {
+	files, _ := filepath.Glob(filepath.Join(d.Dir, "*.json"))
+	for _, file := range files {
+		os.Remove(file)
+	}
+}
+
+func (d *DiskCache) path(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return filepath.Join(d.Dir, hex.EncodeToString(h[:])+".json")
+}
+
+// cacheKey returns the key of the cached GET response of u.
+func cacheKey(u *url.URL) string {
+	return http.MethodGet + " " + u.String()
+}
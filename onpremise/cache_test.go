@@ -0,0 +1,237 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestResponseCache_Hit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `[{"id":"summary","name":"Summary"}]`)
+		}
+	})
+	testClient.Cache = &ResponseCache{}
+
+	for i := 0; i < 3; i++ {
+		fields, _, err := testClient.Field.GetList(context.Background())
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if len(fields) != 1 || fields[0].ID != "summary" {
+			t.Errorf("Unexpected fields: %+v", fields)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request. Got %d", requests)
+	}
+
+	// Mutating requests are never cached and invalidate the endpoint
+	req, _ := testClient.NewRequest(context.Background(), http.MethodPost, "rest/api/2/field", map[string]string{"name": "Custom"})
+	testClient.Do(req, nil)
+	testClient.Do(req, nil)
+	testClient.Field.GetList(context.Background())
+	if requests != 4 {
+		t.Errorf("Expected 4 requests. Got %d", requests)
+	}
+
+	testClient.Cache.Invalidate(testClient, "rest/api/2/field")
+	testClient.Field.GetList(context.Background())
+	if requests != 5 {
+		t.Errorf("Expected 5 requests after invalidation. Got %d", requests)
+	}
+}
+
+func TestResponseCache_Revalidate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/priority", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `[{"id":"1","name":"Highest"}]`)
+	})
+	testClient.Cache = &ResponseCache{TTLs: map[string]time.Duration{"rest/api/2/priority": time.Nanosecond}}
+
+	testClient.Priority.GetList(context.Background())
+	time.Sleep(time.Millisecond)
+
+	priorities, resp, err := testClient.Priority.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(priorities) != 1 || priorities[0].Name != "Highest" {
+		t.Errorf("Unexpected priorities: %+v", priorities)
+	}
+	if got := resp.Header.Get(CacheStatusHeader); got != CacheRevalidated {
+		t.Errorf("Expected revalidated response. Got %q", got)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests. Got %d", requests)
+	}
+}
+
+func TestResponseCache_InvalidateParent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/issueLinkType", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"issueLinkTypes":[{"id":"10000","name":"Blocks","inward":"is blocked by","outward":"blocks"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issueLinkType/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/issueLinkTypeScheme/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testClient.Cache = &ResponseCache{}
+
+	testClient.IssueLinkType.GetList(context.Background())
+	testClient.IssueLinkType.GetList(context.Background())
+	if requests != 1 {
+		t.Fatalf("Expected 1 request. Got %d", requests)
+	}
+
+	// Only paths below the endpoint invalidate it, not paths sharing its prefix
+	req, _ := testClient.NewRequest(context.Background(), http.MethodPut, "rest/api/2/issueLinkTypeScheme/1", nil)
+	testClient.Do(req, nil)
+	testClient.IssueLinkType.GetList(context.Background())
+	if requests != 1 {
+		t.Errorf("Expected 1 request. Got %d", requests)
+	}
+
+	if _, err := testClient.IssueLinkType.Delete(context.Background(), "10000"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	testClient.IssueLinkType.GetList(context.Background())
+	if requests != 2 {
+		t.Errorf("Expected the list to be fetched again after a deletion. Got %d requests", requests)
+	}
+}
+
+func TestResponseCache_InvalidateAfterMutation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	name := "Blocks"
+	received, proceed := make(chan struct{}), make(chan struct{})
+	testMux.HandleFunc("/rest/api/2/issueLinkType", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintf(w, `{"issueLinkTypes":[{"id":"10000","name":%q}]}`, name)
+	})
+	testMux.HandleFunc("/rest/api/2/issueLinkType/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		close(received)
+		<-proceed
+		mu.Lock()
+		name = "Blocks renamed"
+		mu.Unlock()
+		fmt.Fprint(w, `{}`)
+	})
+	testClient.Cache = &ResponseCache{}
+
+	done := make(chan error)
+	go func() {
+		_, _, err := testClient.IssueLinkType.Update(context.Background(), &IssueLinkType{ID: "10000", Name: "Blocks renamed"})
+		done <- err
+	}()
+
+	// A list fetched while the update is in flight caches the old name
+	<-received
+	testClient.IssueLinkType.GetList(context.Background())
+	close(proceed)
+	if err := <-done; err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	linkTypes, _, err := testClient.IssueLinkType.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(linkTypes) != 1 || linkTypes[0].Name != "Blocks renamed" {
+		t.Errorf("Expected the list to be fetched again after the update. Got %+v", linkTypes)
+	}
+}
+
+func TestResponseCache_NotCacheable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/resolution", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	})
+	testMux.HandleFunc("/rest/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `[]`)
+	})
+	testClient.Cache = &ResponseCache{}
+
+	// Errors are not cached
+	testClient.Resolution.GetList(context.Background())
+	testClient.Resolution.GetList(context.Background())
+	// Endpoints without TTL are not cached
+	testClient.Project.GetAll(context.Background(), nil)
+	testClient.Project.GetAll(context.Background(), nil)
+	if requests != 4 {
+		t.Errorf("Expected 4 requests. Got %d", requests)
+	}
+}
+
+func TestMemoryCache_Evict(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", &CachedResponse{})
+	cache.Set("b", &CachedResponse{})
+	cache.Get("a")
+	cache.Set("c", &CachedResponse{})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("Expected least recently used entry to be evicted")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Errorf("Expected entry a to be cached")
+	}
+	cache.Clear()
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("Expected empty cache")
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	cache := &DiskCache{Dir: t.TempDir()}
+	cache.Set("GET https://example.com/rest/api/2/field", &CachedResponse{StatusCode: http.StatusOK, Body: []byte(`[]`), ETag: `"v1"`})
+
+	resp, ok := cache.Get("GET https://example.com/rest/api/2/field")
+	if !ok || string(resp.Body) != `[]` || resp.ETag != `"v1"` {
+		t.Errorf("Unexpected cached response: %+v", resp)
+	}
+
+	cache.Delete("GET https://example.com/rest/api/2/field")
+	if _, ok := cache.Get("GET https://example.com/rest/api/2/field"); ok {
+		t.Errorf("Expected entry to be deleted")
+	}
+}
@@ -0,0 +1,167 @@
+package onpremise
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// DryRunHeader is set on the synthetic responses of requests captured in dry-run mode.
+const DryRunHeader = "X-Dry-Run"
+
+// Plan is the list of mutating requests captured by a client in dry-run mode, see Client.DryRun.
+//
+// A plan can be serialized to JSON, reviewed and applied later with Apply.
+type Plan struct {
+	mu sync.Mutex
+
+	// Requests are the captured requests in the order they were sent.
+	Requests []PlannedRequest `json:"requests"`
+}
+
+// PlannedRequest is a POST, PUT or DELETE request captured in dry-run mode.
+type PlannedRequest struct {
+	// Operation is the name of the service method which sent the request, like "Group.Add".
+	// It is empty if Client.Do was called directly.
+	Operation string `json:"operation,omitempty"`
+
+	Method string `json:"method"`
+
+	// Path is the path and query of the request relative to the BaseURL of the client,
+	// like "rest/api/2/group/user?groupname=jira-users".
+	Path string `json:"path"`
+
+	ContentType string `json:"contentType,omitempty"`
+
+	// Body is the request body if it is JSON, like the bodies of requests built by NewRequest.
+	Body json.RawMessage `json:"body,omitempty"`
+
+	// RawBody is any other request body, like the multipart forms of attachments.
+	RawBody []byte `json:"rawBody,omitempty"`
+}
+
+// NewPlan returns an empty plan.
+func NewPlan() *Plan {
+	return &Plan{}
+}
+
+// Len returns the number of captured requests.
+func (p *Plan) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.Requests)
+}
+
+// Apply sends the requests of the plan in order with client, which must not be in dry-run mode
+// for the requests to reach Jira. It stops at the first failing request and returns the responses
+// of the requests sent so far.
+func (p *Plan) Apply(ctx context.Context, client *Client) ([]*Response, error) {
+	p.mu.Lock()
+	requests := append([]PlannedRequest(nil), p.Requests...)
+	p.mu.Unlock()
+
+	responses := make([]*Response, 0, len(requests))
+	for i, planned := range requests {
+		req, err := planned.newRequest(ctx, client)
+		if err != nil {
+			return responses, fmt.Errorf("request %d (%s %s): %w", i, planned.Method, planned.Path, err)
+		}
+		resp, err := client.Do(req, nil)
+		if err != nil {
+			err = NewJiraError(resp, err)
+		}
+		if resp != nil {
+			resp.Body.Close()
+			responses = append(responses, resp)
+		}
+		if err != nil {
+			return responses, fmt.Errorf("request %d (%s %s): %w", i, planned.Method, planned.Path, err)
+		}
+	}
+	return responses, nil
+}
+
+// newRequest rebuilds the captured request for client.
+func (r PlannedRequest) newRequest(ctx context.Context, client *Client) (*http.Request, error) {
+	var body io.Reader
+	switch {
+	case len(r.Body) > 0:
+		body = bytes.NewReader(r.Body)
+	case len(r.RawBody) > 0:
+		body = bytes.NewReader(r.RawBody)
+	}
+	req, err := client.NewRawRequest(ctx, r.Method, r.Path, body)
+	if err != nil {
+		return nil, err
+	}
+	if r.ContentType != "" {
+		req.Header.Set("Content-Type", r.ContentType)
+	}
+	if strings.HasPrefix(r.ContentType, "multipart/") {
+		req.Header.Set("X-Atlassian-Token", "nocheck")
+	}
+	return req, nil
+}
+
+// capturedBody is the body of the synthetic responses of requests captured in dry-run mode.
+type capturedBody struct {
+	io.ReadCloser
+}
+
+// isCaptured reports whether resp is the synthetic response of a request captured in dry-run mode.
+func isCaptured(resp *http.Response) bool {
+	_, captured := resp.Body.(capturedBody)
+	return captured
+}
+
+// isMutation reports whether requests with the method are captured in dry-run mode.
+func isMutation(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut || method == http.MethodDelete
+}
+
+// capture adds req to the plan and returns a synthetic successful response.
+// The response body is an empty JSON object, which Client.Do does not decode.
+func (p *Plan) capture(c *Client, req *http.Request, operation string) (*http.Response, error) {
+	planned := PlannedRequest{
+		Operation:   operation,
+		Method:      req.Method,
+		Path:        strings.TrimPrefix(req.URL.RequestURI(), c.BaseURL.Path),
+		ContentType: req.Header.Get("Content-Type"),
+	}
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(body) > 0 && json.Valid(body) {
+			planned.Body = json.RawMessage(bytes.TrimSpace(body))
+		} else if len(body) > 0 {
+			planned.RawBody = body
+		}
+	}
+
+	p.mu.Lock()
+	p.Requests = append(p.Requests, planned)
+	p.mu.Unlock()
+
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+			DryRunHeader:   {"true"},
+		},
+		Body:          capturedBody{io.NopCloser(strings.NewReader("{}"))},
+		ContentLength: 2,
+		Request:       req,
+	}, nil
+}
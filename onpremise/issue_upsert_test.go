@@ -0,0 +1,137 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueService_UpsertByExternalID_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("jql"), `project = "EX" AND issue.property["externalId"].value = "ext-1"`; got != want {
+			t.Errorf("jql = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":0,"issues":[]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body struct {
+			Fields struct {
+				Project struct {
+					Key string `json:"key"`
+				} `json:"project"`
+				Summary string `json:"summary"`
+			} `json:"fields"`
+			Properties []EntityProperty `json:"properties"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Fields.Project.Key != "EX" || body.Fields.Summary != "Sync me" {
+			t.Errorf("unexpected fields %+v", body.Fields)
+		}
+		if len(body.Properties) != 1 || body.Properties[0].Key != "externalId" || body.Properties[0].Value != "ext-1" {
+			t.Errorf("unexpected properties %+v", body.Properties)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","self":"https://jira.example.com/rest/api/2/issue/10002"}`)
+	})
+
+	result, _, err := testClient.Issue.UpsertByExternalID(context.Background(), "EX", "ext-1", &Issue{Fields: &IssueFields{Summary: "Sync me"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !result.Created || result.Issue.Key != "EX-1" || result.Issue.ID != "10002" {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func TestIssueService_UpsertByExternalID_UpdateByField(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("jql"), `project = "EX" AND cf[10050] ~ "\"ext-1\""`; got != want {
+			t.Errorf("jql = %q, want %q", got, want)
+		}
+		if got, want := r.URL.Query().Get("fields"), "key,customfield_10050"; got != want {
+			t.Errorf("fields = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"issues":[
+			{"id":"10001","key":"EX-1","fields":{"customfield_10050":"ext-10"}},
+			{"id":"10002","key":"EX-2","fields":{"customfield_10050":"ext-1"}}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body struct {
+			Fields map[string]interface{} `json:"fields"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := body.Fields["project"]; ok {
+			t.Error("project must not be updated")
+		}
+		if body.Fields["summary"] != "Sync me" {
+			t.Errorf("unexpected fields %+v", body.Fields)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	result, _, err := testClient.Issue.UpsertByExternalID(context.Background(), "EX", "ext-1", &Issue{Fields: &IssueFields{Summary: "Sync me"}}, WithExternalIDField("customfield_10050"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.Created || result.Issue.Key != "EX-2" {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func TestIssueService_UpsertByExternalID_FieldPages(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		switch startAt := r.URL.Query().Get("startAt"); startAt {
+		case "", "0":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"issues":[{"id":"10001","key":"EX-1","fields":{"customfield_10050":"ext-1 copy"}}]}`)
+		case "1":
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"issues":[{"id":"10002","key":"EX-2","fields":{"customfield_10050":"ext-1"}}]}`)
+		default:
+			t.Errorf("unexpected startAt %s", startAt)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the issue must not be created")
+	})
+
+	result, _, err := testClient.Issue.UpsertByExternalID(context.Background(), "EX", "ext-1", &Issue{Fields: &IssueFields{Summary: "Sync me"}}, WithExternalIDField("customfield_10050"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.Created || result.Issue.Key != "EX-2" {
+		t.Errorf("unexpected result %+v", result)
+	}
+}
+
+func TestIssueService_UpsertByExternalID_Ambiguous(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"issues":[{"id":"10001","key":"EX-1"},{"id":"10002","key":"EX-2"}]}`)
+	})
+
+	_, _, err := testClient.Issue.UpsertByExternalID(context.Background(), "EX", "ext-1", &Issue{Fields: &IssueFields{Summary: "Sync me"}}, WithExternalIDProperty("sync"))
+	if !errors.Is(err, ErrAmbiguousExternalID) {
+		t.Errorf("expected ErrAmbiguousExternalID, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package onpremise
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -25,7 +26,7 @@ func (s *IssueLinkTypeService) GetList(ctx context.Context) ([]IssueLinkType, *R
 		return nil, nil, err
 	}
 
-	linkTypeList := []IssueLinkType{}
+	linkTypeList := issueLinkTypeList{}
 	resp, err := s.client.Do(req, &linkTypeList)
 	if err != nil {
 		return nil, resp, NewJiraError(resp, err)
@@ -33,6 +34,24 @@ func (s *IssueLinkTypeService) GetList(ctx context.Context) ([]IssueLinkType, *R
 	return linkTypeList, resp, nil
 }
 
+// issueLinkTypeList decodes the issue link types returned by Jira,
+// which wraps them in an object, as well as a plain list.
+type issueLinkTypeList []IssueLinkType
+
+func (l *issueLinkTypeList) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		return json.Unmarshal(data, (*[]IssueLinkType)(l))
+	}
+	var wrapper struct {
+		IssueLinkTypes []IssueLinkType `json:"issueLinkTypes"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return err
+	}
+	*l = wrapper.IssueLinkTypes
+	return nil
+}
+
 // Get gets info of a specific issue link type from Jira.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-issueLinkType-issueLinkTypeId-get
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -21,6 +22,8 @@ const (
 	defaultUserAgent = "go-jira" + "/" + ClientVersion
 )
 
+//go:generate go run ../internal/servicegen
+
 // A Client manages communication with the Jira API.
 type Client struct {
 	clientMu sync.Mutex   // clientMu protects the client during calls that modify it.
@@ -34,36 +37,64 @@ type Client struct {
 	// User agent used when communicating with the Jira API.
 	UserAgent string
 
+	// Cache caches the responses of rarely changing endpoints. Optional.
+	Cache *ResponseCache
+
+	// Logger logs the API requests of the client. Optional.
+	// Authorization headers, cookies, JWTs, tokens and passwords are redacted.
+	Logger *slog.Logger
+
+	// LogOptions configure the logging of requests.
+	LogOptions LogOptions
+
+	// DryRun enables the dry-run mode if set: POST, PUT and DELETE requests are not sent,
+	// but captured into the plan and answered with a synthetic successful response
+	// carrying the DryRunHeader. Other requests are sent as usual. Optional.
+	DryRun *Plan
+
+	// middlewares wrap sending requests, see Use.
+	middlewares []Middleware
+
 	// Session storage if the user authenticates with a Session cookie
 	// TODO Needed in Cloud and/or onpremise?
 	session *Session
 
+	// authentication holds the credentials of the deprecated AuthenticationService.
+	// It is kept apart from the Authentication field, so that field can be replaced in tests.
+	authentication *AuthenticationService
+
 	// Reuse a single struct instead of allocating one for each service on the heap.
 	common service
 
 	// Services used for talking to different parts of the Jira API.
-	Authentication   *AuthenticationService
-	Issue            *IssueService
-	Project          *ProjectService
-	Board            *BoardService
-	Sprint           *SprintService
-	User             *UserService
-	Group            *GroupService
-	Version          *VersionService
-	Priority         *PriorityService
-	Field            *FieldService
-	Component        *ComponentService
-	Resolution       *ResolutionService
-	StatusCategory   *StatusCategoryService
-	Filter           *FilterService
-	Role             *RoleService
-	PermissionScheme *PermissionSchemeService
-	Status           *StatusService
-	IssueLinkType    *IssueLinkTypeService
-	Organization     *OrganizationService
-	ServiceDesk      *ServiceDeskService
-	Customer         *CustomerService
-	Request          *RequestService
+	// They are interfaces, so they can be replaced by the mocks of the mocks package in tests.
+	Authentication   AuthenticationAPI
+	Issue            IssueAPI
+	Project          ProjectAPI
+	Board            BoardAPI
+	Sprint           SprintAPI
+	User             UserAPI
+	Group            GroupAPI
+	Version          VersionAPI
+	Priority         PriorityAPI
+	Field            FieldAPI
+	Component        ComponentAPI
+	Resolution       ResolutionAPI
+	StatusCategory   StatusCategoryAPI
+	Filter           FilterAPI
+	Role             RoleAPI
+	PermissionScheme PermissionSchemeAPI
+	Status           StatusAPI
+	IssueLinkType    IssueLinkTypeAPI
+	Organization     OrganizationAPI
+	ServiceDesk      ServiceDeskAPI
+	Customer         CustomerAPI
+	Request          RequestAPI
+	PAT              PATAPI
+	ServerInfo       ServerInfoAPI
+	Property         PropertyAPI
+	ApplicationRole  ApplicationRoleAPI
+	ProjectCategory  ProjectCategoryAPI
 }
 
 // service is the base structure to bundle API services
@@ -106,7 +137,8 @@ func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
 	c.common.client = c
 
 	// TODO Check if the authentication service is still needed (because of the transports)
-	c.Authentication = &AuthenticationService{client: c}
+	c.authentication = &AuthenticationService{client: c}
+	c.Authentication = c.authentication
 	c.Issue = (*IssueService)(&c.common)
 	c.Project = (*ProjectService)(&c.common)
 	c.Board = (*BoardService)(&c.common)
@@ -128,6 +160,11 @@ func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
 	c.ServiceDesk = (*ServiceDeskService)(&c.common)
 	c.Customer = (*CustomerService)(&c.common)
 	c.Request = (*RequestService)(&c.common)
+	c.PAT = (*PATService)(&c.common)
+	c.ServerInfo = (*ServerInfoService)(&c.common)
+	c.Property = (*PropertyService)(&c.common)
+	c.ApplicationRole = (*ApplicationRoleService)(&c.common)
+	c.ProjectCategory = (*ProjectCategoryService)(&c.common)
 
 	return c, nil
 }
@@ -154,17 +191,17 @@ func (c *Client) NewRawRequest(ctx context.Context, method, urlStr string, body
 	req.Header.Set("Content-Type", "application/json")
 
 	// Set authentication information
-	if c.Authentication.authType == authTypeSession {
+	if c.authentication.authType == authTypeSession {
 		// Set session cookie if there is one
 		if c.session != nil {
 			for _, cookie := range c.session.Cookies {
 				req.AddCookie(cookie)
 			}
 		}
-	} else if c.Authentication.authType == authTypeBasic {
+	} else if c.authentication.authType == authTypeBasic {
 		// Set basic auth information
-		if c.Authentication.username != "" {
-			req.SetBasicAuth(c.Authentication.username, c.Authentication.password)
+		if c.authentication.username != "" {
+			req.SetBasicAuth(c.authentication.username, c.authentication.password)
 		}
 	}
 
@@ -203,17 +240,17 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	req.Header.Set("Content-Type", "application/json")
 
 	// Set authentication information
-	if c.Authentication.authType == authTypeSession {
+	if c.authentication.authType == authTypeSession {
 		// Set session cookie if there is one
 		if c.session != nil {
 			for _, cookie := range c.session.Cookies {
 				req.AddCookie(cookie)
 			}
 		}
-	} else if c.Authentication.authType == authTypeBasic {
+	} else if c.authentication.authType == authTypeBasic {
 		// Set basic auth information
-		if c.Authentication.username != "" {
-			req.SetBasicAuth(c.Authentication.username, c.Authentication.password)
+		if c.authentication.username != "" {
+			req.SetBasicAuth(c.authentication.username, c.authentication.password)
 		}
 	}
 
@@ -244,8 +281,10 @@ func addOptions(s string, opts interface{}) (string, error) {
 
 // NewMultiPartRequest creates an API request including a multi-part file.
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
-// If specified, the value pointed to by buf is a multipart form.
-func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
+// If specified, buf provides the multipart form. It can be any io.Reader, which allows streaming the form
+// (the caller is responsible to set the ContentLength of the returned request for readers other than
+// *bytes.Buffer, *bytes.Reader and *strings.Reader).
+func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string, buf io.Reader) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -264,17 +303,17 @@ func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string,
 	req.Header.Set("X-Atlassian-Token", "nocheck")
 
 	// Set authentication information
-	if c.Authentication.authType == authTypeSession {
+	if c.authentication.authType == authTypeSession {
 		// Set session cookie if there is one
 		if c.session != nil {
 			for _, cookie := range c.session.Cookies {
 				req.AddCookie(cookie)
 			}
 		}
-	} else if c.Authentication.authType == authTypeBasic {
+	} else if c.authentication.authType == authTypeBasic {
 		// Set basic auth information
-		if c.Authentication.username != "" {
-			req.SetBasicAuth(c.Authentication.username, c.Authentication.password)
+		if c.authentication.username != "" {
+			req.SetBasicAuth(c.authentication.username, c.authentication.password)
 		}
 	}
 
@@ -284,7 +323,7 @@ func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string,
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occurred.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	httpResp, err := c.client.Do(req)
+	httpResp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -299,7 +338,10 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 	if v != nil {
 		// Open a NewDecoder and defer closing the reader only if there is a provided interface to decode to
 		defer httpResp.Body.Close()
-		err = json.NewDecoder(httpResp.Body).Decode(v)
+		// The synthetic responses of the dry-run mode have no content to decode
+		if !isCaptured(httpResp) {
+			err = json.NewDecoder(httpResp.Body).Decode(v)
+		}
 	}
 
 	resp := newResponse(httpResp, v)
@@ -229,7 +229,7 @@ func TestClient_NewRequest_SessionCookies(t *testing.T) {
 
 	cookie := &http.Cookie{Name: "testcookie", Value: "testvalue"}
 	c.session = &Session{Cookies: []*http.Cookie{cookie}}
-	c.Authentication.authType = authTypeSession
+	c.authentication.authType = authTypeSession
 
 	inURL := "rest/api/2/issue/"
 	inBody := &Issue{Key: "MESOS"}
@@ -298,7 +298,7 @@ func TestClient_NewMultiPartRequest(t *testing.T) {
 
 	cookie := &http.Cookie{Name: "testcookie", Value: "testvalue"}
 	c.session = &Session{Cookies: []*http.Cookie{cookie}}
-	c.Authentication.authType = authTypeSession
+	c.authentication.authType = authTypeSession
 
 	inURL := "rest/api/2/issue/"
 	inBuf := bytes.NewBufferString("teststring")
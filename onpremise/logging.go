@@ -0,0 +1,318 @@
+package onpremise
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultMaxLogBodySize is the number of bytes of request and response bodies which are logged by default.
+const DefaultMaxLogBodySize = 2048
+
+// redacted replaces secrets in logs.
+const redacted = "[REDACTED]"
+
+// LogOptions configure the logging of requests, see Client.Logger and LoggingTransport.
+type LogOptions struct {
+	// Level is the level of successful requests. It defaults to slog.LevelDebug.
+	Level slog.Leveler
+
+	// ErrorLevel is the level of failed requests and responses with a 4xx or 5xx status code.
+	// It defaults to slog.LevelWarn.
+	ErrorLevel slog.Leveler
+
+	// MaxBodySize is the number of bytes of request and response bodies which are logged.
+	// It defaults to DefaultMaxLogBodySize. If negative, bodies are not logged.
+	MaxBodySize int
+
+	// Dump logs the headers and complete bodies of requests and responses.
+	// Secrets are redacted nonetheless. Meant for debugging only.
+	Dump bool
+}
+
+// LoggingTransport is an http.RoundTripper which logs all requests.
+// Use it below an auth transport to see the requests as they are sent on the wire, e.g. the login of the
+// CookieAuthTransport. Use Client.Logger to log the API requests of a client together with their operation.
+//
+// Authorization headers, cookies (also session cookies in JSON bodies), JWTs, tokens and passwords are redacted.
+type LoggingTransport struct {
+	Logger  *slog.Logger
+	Options LogOptions
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	logExchange(t.Logger, &t.Options, req, resp, err, time.Since(start))
+	return resp, err
+}
+
+// logCall is the Middleware logging the API requests of a client.
+func (c *Client) logCall(next Handler) Handler {
+	return func(call *Call) error {
+		err := next(call)
+		logExchange(c.Logger, &c.LogOptions, call.Request, call.Response, call.Err, call.Latency,
+			slog.String("operation", call.Operation),
+			slog.Int("attempt", call.Attempt),
+		)
+		return err
+	}
+}
+
+// logExchange logs a request and its response. The response body is restored, so it can be read again.
+func logExchange(logger *slog.Logger, opts *LogOptions, req *http.Request, resp *http.Response, err error, latency time.Duration, attrs ...slog.Attr) {
+	if logger == nil {
+		return
+	}
+
+	level := slog.LevelDebug
+	if opts.Level != nil {
+		level = opts.Level.Level()
+	}
+	if err != nil || (resp != nil && resp.StatusCode >= 400) {
+		level = slog.LevelWarn
+		if opts.ErrorLevel != nil {
+			level = opts.ErrorLevel.Level()
+		}
+	}
+	ctx := req.Context()
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	logBodies := opts.MaxBodySize >= 0 || opts.Dump
+	limit := opts.MaxBodySize
+	if limit == 0 {
+		limit = DefaultMaxLogBodySize
+	}
+	if opts.Dump {
+		limit = -1 // complete bodies
+	}
+
+	attrs = append(attrs,
+		slog.String("method", req.Method),
+		slog.String("url", redactURL(req.URL)),
+		slog.Duration("latency", latency),
+	)
+	if opts.Dump {
+		attrs = append(attrs, slog.Any("request_header", redactHeader(req.Header)))
+	}
+	if logBodies {
+		if body, ok := requestBody(req, limit); ok {
+			attrs = append(attrs, slog.String("request_body", body))
+		}
+	}
+
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		if opts.Dump {
+			attrs = append(attrs, slog.Any("response_header", redactHeader(resp.Header)))
+		}
+		if logBodies {
+			attrs = append(attrs, slog.String("response_body", responseBody(resp, limit)))
+		}
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", redactText(err.Error())))
+	}
+
+	logger.LogAttrs(ctx, level, "jira request", attrs...)
+}
+
+// requestBody returns the redacted body of req if it can be read without consuming it.
+func requestBody(req *http.Request, limit int) (string, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", false
+	}
+	if req.GetBody == nil {
+		return "[streamed]", true
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return "", false
+	}
+	defer body.Close()
+	b, truncated := readLimited(body, limit)
+	return formatBody(b, truncated), true
+}
+
+// responseBody returns the redacted body of resp and restores it.
+func responseBody(resp *http.Response, limit int) string {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return ""
+	}
+	b, _ := readLimited(resp.Body, limit)
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(b), resp.Body), resp.Body}
+
+	truncated := false
+	if limit >= 0 && len(b) > limit {
+		b, truncated = b[:limit], true
+	}
+	return formatBody(b, truncated)
+}
+
+// readLimited reads up to limit+1 bytes of r, so truncation can be detected. A negative limit reads everything.
+func readLimited(r io.Reader, limit int) ([]byte, bool) {
+	if limit < 0 {
+		b, _ := io.ReadAll(r)
+		return b, false
+	}
+	b, _ := io.ReadAll(io.LimitReader(r, int64(limit)+1))
+	if len(b) > limit {
+		return b, true
+	}
+	return b, false
+}
+
+func formatBody(b []byte, truncated bool) string {
+	if truncated {
+		// A truncated JSON document cannot be parsed, redact what looks like secrets in the text
+		return redactText(string(b)) + "...[truncated]"
+	}
+	return redactBody(b)
+}
+
+// secretFields are JSON fields and form parameters whose values are redacted.
+var secretFields = map[string]bool{
+	"password":      true,
+	"token":         true,
+	"rawtoken":      true,
+	"apitoken":      true,
+	"secret":        true,
+	"sharedsecret":  true,
+	"client_secret": true,
+	"access_token":  true,
+	"refresh_token": true,
+	"oauth_token":   true,
+	"jwt":           true,
+}
+
+// sessionCookies are the names of the cookies of Jira sessions, whose values are redacted
+// in JSON objects like {"name":"JSESSIONID","value":"..."}, as returned by the session resource.
+var sessionCookies = map[string]bool{
+	"jsessionid":               true,
+	"atlassian.xsrf.token":     true,
+	"cloud.session.token":      true,
+	"tenant.session.token":     true,
+	"seraph.rememberme.cookie": true,
+	"crowd.token_key":          true,
+	"studio.crowd.tokenkey":    true,
+}
+
+var (
+	jwtPattern         = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	secretFieldPattern = regexp.MustCompile(`(?i)("(?:password|token|rawToken|apiToken|secret|sharedSecret|client_secret|access_token|refresh_token)"\s*:\s*)"[^"]*"?`)
+	secretParamPattern = regexp.MustCompile(`(?i)\b((?:password|client_secret|access_token|refresh_token|oauth_token|oauth_token_secret|oauth_verifier)=)[^&\s]*`)
+	sessionPattern     = regexp.MustCompile(`(?i)("session"\s*:\s*\{[^}]*?"value"\s*:\s*)"[^"]*"?`)
+	cookiePattern      = regexp.MustCompile(`(?i)\b((?:JSESSIONID|atlassian\.xsrf\.token|cloud\.session\.token|tenant\.session\.token|seraph\.rememberme\.cookie|crowd\.token_key|studio\.crowd\.tokenkey)=)[^;,&\s]*`)
+)
+
+// redactBody redacts the secret fields of a JSON body, or redacts what looks like secrets in other bodies.
+func redactBody(b []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return redactText(string(b))
+	}
+	redactJSON(v)
+	out, err := json.Marshal(v)
+	if err != nil {
+		return redactText(string(b))
+	}
+	return redactText(string(out))
+}
+
+func redactJSON(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if name, ok := v["name"].(string); ok && sessionCookies[strings.ToLower(name)] {
+			if _, ok := v["value"]; ok {
+				v["value"] = redacted
+			}
+		}
+		for key, value := range v {
+			if secretFields[strings.ToLower(key)] {
+				v[key] = redacted
+				continue
+			}
+			if session, ok := value.(map[string]interface{}); ok && strings.EqualFold(key, "session") {
+				if _, ok := session["value"]; ok {
+					session["value"] = redacted
+				}
+			}
+			redactJSON(value)
+		}
+	case []interface{}:
+		for _, value := range v {
+			redactJSON(value)
+		}
+	}
+}
+
+// redactText redacts JWTs, secret JSON fields, session cookies and form parameters in text.
+func redactText(s string) string {
+	s = secretFieldPattern.ReplaceAllString(s, `$1"`+redacted+`"`)
+	s = sessionPattern.ReplaceAllString(s, `$1"`+redacted+`"`)
+	s = secretParamPattern.ReplaceAllString(s, `${1}`+redacted)
+	s = cookiePattern.ReplaceAllString(s, `${1}`+redacted)
+	return jwtPattern.ReplaceAllString(s, redacted)
+}
+
+// redactURL returns u with secret query parameters redacted.
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	changed := false
+	for key := range q {
+		if secretFields[strings.ToLower(key)] {
+			q.Set(key, redacted)
+			changed = true
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+	u2 := *u
+	u2.RawQuery = q.Encode()
+	return u2.String()
+}
+
+// redactHeader returns a copy of h with credentials redacted.
+func redactHeader(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for key, values := range h {
+		value := strings.Join(values, ", ")
+		switch http.CanonicalHeaderKey(key) {
+		case "Authorization", "Proxy-Authorization":
+			// Keep the scheme, like "Bearer"
+			if scheme, _, ok := strings.Cut(value, " "); ok {
+				value = scheme + " " + redacted
+			} else {
+				value = redacted
+			}
+		case "Cookie", "Set-Cookie":
+			value = redacted
+		default:
+			value = redactText(value)
+		}
+		out[key] = value
+	}
+	return out
+}
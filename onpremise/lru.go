@@ -0,0 +1,59 @@
+package onpremise
+
+import "container/list"
+
+// lru is a map of a fixed size which evicts the least recently used entries.
+// It is not safe for concurrent use.
+type lru[V any] struct {
+	size    int
+	entries map[string]*list.Element
+	list    *list.List
+}
+
+type lruEntry[V any] struct {
+	key   string
+	value V
+}
+
+func newLRU[V any](size int) *lru[V] {
+	if size < 1 {
+		size = 1
+	}
+	return &lru[V]{size: size, entries: make(map[string]*list.Element), list: list.New()}
+}
+
+func (l *lru[V]) get(key string) (V, bool) {
+	el, ok := l.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	l.list.MoveToFront(el)
+	return el.Value.(*lruEntry[V]).value, true
+}
+
+func (l *lru[V]) set(key string, value V) {
+	if el, ok := l.entries[key]; ok {
+		el.Value.(*lruEntry[V]).value = value
+		l.list.MoveToFront(el)
+		return
+	}
+	l.entries[key] = l.list.PushFront(&lruEntry[V]{key: key, value: value})
+	for l.list.Len() > l.size {
+		oldest := l.list.Back()
+		l.list.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry[V]).key)
+	}
+}
+
+func (l *lru[V]) delete(key string) {
+	if el, ok := l.entries[key]; ok {
+		l.list.Remove(el)
+		delete(l.entries, key)
+	}
+}
+
+func (l *lru[V]) clear() {
+	l.entries = make(map[string]*list.Element)
+	l.list.Init()
+}
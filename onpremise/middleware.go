@@ -0,0 +1,237 @@
+package onpremise
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Call is an API request passing through the middleware chain of a Client.
+type Call struct {
+	// Operation is the name of the service method which sent the request, like "Issue.Search".
+	// It is empty if Client.Do was called directly.
+	Operation string
+
+	// PathTemplate is the path of the request relative to the BaseURL of the client,
+	// with identifiers replaced by "{id}", like "/rest/api/2/issue/{id}/comment".
+	PathTemplate string
+
+	// Request is the request to send. A middleware may replace it before calling the next handler.
+	Request *http.Request
+
+	// The following fields are set once the next handler returned.
+
+	// Attempt is the number of times the request was sent, starting at 1.
+	// It is increased every time a middleware calls the next handler again, e.g. to retry the request.
+	Attempt int
+
+	// Response is the response of Jira. It is nil if the request failed.
+	Response *http.Response
+
+	// StatusCode is the status code of the response, or 0 if the request failed.
+	StatusCode int
+
+	// Latency is the time from sending the request until the response headers were received.
+	Latency time.Duration
+
+	// Err is the error of the request. For responses outside the 2xx range, it is the decoded *Error
+	// of the response body. The body of the response is still readable.
+	Err error
+}
+
+// Handler sends a Call. It returns an error if the request could not be sent.
+type Handler func(call *Call) error
+
+// Middleware wraps the Handler sending API requests, e.g. to trace or measure them.
+//
+// A middleware must call next to send the request, or else set call.Response or return an error.
+// The fields describing the outcome of the call are set once next returned:
+//
+//	func Logging(next onpremise.Handler) onpremise.Handler {
+//		return func(call *onpremise.Call) error {
+//			err := next(call)
+//			log.Printf("%s %s: %d in %s", call.Operation, call.PathTemplate, call.StatusCode, call.Latency)
+//			return err
+//		}
+//	}
+type Middleware func(next Handler) Handler
+
+// Use appends middlewares to the chain of the client.
+// The first middleware is the outermost one.
+// Use is not safe for concurrent use with requests.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// send sends req through the middleware chain of the client.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if c.DryRun != nil && isMutation(req.Method) {
+		return c.DryRun.capture(c, req, callerOperation())
+	}
+
+	middlewares := c.middlewares
+	if c.Logger != nil {
+		// Log every attempt
+		middlewares = append(middlewares[:len(middlewares):len(middlewares)], c.logCall)
+	}
+	if len(middlewares) == 0 {
+		return c.roundTrip(req)
+	}
+
+	call := &Call{
+		Operation:    callerOperation(),
+		PathTemplate: pathTemplate(c.BaseURL.Path, req.URL.Path),
+		Request:      req,
+	}
+	handler := c.handle
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	if err := handler(call); err != nil {
+		return nil, err
+	}
+	if call.Response == nil {
+		// A middleware returned without calling next or setting a response
+		return nil, fmt.Errorf("%s: middleware returned no response", call.Request.URL.Path)
+	}
+	return call.Response, nil
+}
+
+// handle is the innermost Handler of the middleware chain.
+func (c *Client) handle(call *Call) error {
+	call.Attempt++
+	call.Response, call.StatusCode, call.Err = nil, 0, nil
+
+	start := time.Now()
+	resp, err := c.roundTrip(call.Request)
+	call.Latency = time.Since(start)
+	if err != nil {
+		call.Err = err
+		return err
+	}
+
+	call.Response = resp
+	call.StatusCode = resp.StatusCode
+	call.Err = decodeError(resp)
+	return nil
+}
+
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	if c.Cache != nil {
+		return c.Cache.do(c, c.client, req)
+	}
+	return c.client.Do(req)
+}
+
+// decodeError returns the decoded error of a response outside the 2xx range.
+// The body of the response is replaced, so it can be read again.
+func decodeError(resp *http.Response) error {
+	httpError := CheckResponse(resp)
+	if httpError == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%s: %w", httpError.Error(), err)
+	}
+
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		jerr := &Error{HTTPError: httpError}
+		if err := json.Unmarshal(body, jerr); err == nil {
+			return jerr
+		}
+	}
+	return httpError
+}
+
+// servicePackage is the import path of this package, used to find service methods on the call stack.
+var servicePackage = reflect.TypeOf(Client{}).PkgPath()
+
+// callerOperation returns the name of the exported service method on the call stack, like "Issue.Search".
+func callerOperation() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if operation, ok := serviceMethod(frame.Function); ok {
+			return operation
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// serviceMethod parses a function name like "github.com/.../cloud.(*IssueService).Search".
+func serviceMethod(function string) (string, bool) {
+	rest, ok := strings.CutPrefix(function, servicePackage+".(*")
+	if !ok {
+		return "", false
+	}
+	typeName, method, ok := strings.Cut(rest, ").")
+	if !ok || !strings.HasSuffix(typeName, "Service") {
+		return "", false
+	}
+	// Closures are named like "Method.func1"
+	method, _, _ = strings.Cut(method, ".")
+	if method == "" || !unicode.IsUpper([]rune(method)[0]) {
+		return "", false
+	}
+	return strings.TrimSuffix(typeName, "Service") + "." + method, true
+}
+
+// pathTemplate returns path relative to basePath with identifier segments replaced by "{id}".
+// The resource names of the Jira API are lower or camel case words, so segments which contain a digit,
+// are upper case (like project keys) or contain special characters are considered identifiers.
+func pathTemplate(basePath, path string) string {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(path, basePath), "/"), "/")
+	for i, segment := range segments {
+		// Keep the API prefix, like "rest/api/2" or "rest/agile/1.0"
+		if i < 2 || (i == 2 && isAPIVersion(segment)) {
+			continue
+		}
+		if isIdentifier(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func isAPIVersion(segment string) bool {
+	if segment == "latest" {
+		return true
+	}
+	for _, r := range segment {
+		if !unicode.IsDigit(r) && r != '.' {
+			return false
+		}
+	}
+	return segment != ""
+}
+
+func isIdentifier(segment string) bool {
+	hasLower := false
+	for _, r := range segment {
+		switch {
+		case unicode.IsDigit(r):
+			return true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+		default:
+			return true
+		}
+	}
+	return !hasLower && segment != ""
+}
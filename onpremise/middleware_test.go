@@ -0,0 +1,191 @@
+package onpremise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_Use(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Trace") != "trace-id" {
+			t.Errorf("Expected the middleware to modify the request")
+		}
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1"}`)
+	})
+
+	var calls []Call
+	testClient.Use(func(next Handler) Handler {
+		return func(call *Call) error {
+			call.Request.Header.Set("X-Trace", "trace-id")
+			err := next(call)
+			calls = append(calls, *call)
+			return err
+		}
+	})
+
+	issue, _, err := testClient.Issue.Get(context.Background(), "10002", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-1" {
+		t.Errorf("Unexpected issue: %+v", issue)
+	}
+
+	if len(calls) != 1 {
+		t.Fatalf("Expected 1 call. Got %d", len(calls))
+	}
+	call := calls[0]
+	if call.Operation != "Issue.Get" {
+		t.Errorf("Expected operation Issue.Get. Got %q", call.Operation)
+	}
+	if call.PathTemplate != "/rest/api/2/issue/{id}" {
+		t.Errorf("Expected path template /rest/api/2/issue/{id}. Got %q", call.PathTemplate)
+	}
+	if call.Attempt != 1 || call.StatusCode != http.StatusOK || call.Err != nil || call.Latency <= 0 {
+		t.Errorf("Unexpected call: %+v", call)
+	}
+}
+
+func TestClient_Use_Retry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment/10000", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"errorMessages":["Try again later"],"errors":{}}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var decoded []error
+	var attempts int
+	testClient.Use(func(next Handler) Handler {
+		return func(call *Call) error {
+			for {
+				if err := next(call); err != nil {
+					return err
+				}
+				decoded = append(decoded, call.Err)
+				if call.StatusCode != http.StatusServiceUnavailable {
+					attempts = call.Attempt
+					return nil
+				}
+				call.Response.Body.Close()
+			}
+		}
+	})
+
+	if err := testClient.Issue.DeleteComment(context.Background(), "EX-1", "10000"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts. Got %d", attempts)
+	}
+
+	var jerr *Error
+	if len(decoded) != 2 || !errors.As(decoded[0], &jerr) || jerr.ErrorMessages[0] != "Try again later" || decoded[1] != nil {
+		t.Errorf("Unexpected decoded errors: %v", decoded)
+	}
+}
+
+func TestClient_Use_ErrorBodyReadable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-404", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages":["Issue does not exist"],"errors":{}}`)
+	})
+
+	var operation string
+	testClient.Use(func(next Handler) Handler {
+		return func(call *Call) error {
+			err := next(call)
+			operation = call.Operation
+			return err
+		}
+	})
+
+	_, _, err := testClient.Issue.Get(context.Background(), "EX-404", nil)
+	var jerr *Error
+	if !errors.As(err, &jerr) || jerr.ErrorMessages[0] != "Issue does not exist" {
+		t.Errorf("Expected the service to decode the error. Got %v", err)
+	}
+	if operation != "Issue.Get" {
+		t.Errorf("Expected operation Issue.Get. Got %q", operation)
+	}
+}
+
+func TestClient_Use_Direct(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {})
+
+	var call Call
+	testClient.Use(func(next Handler) Handler {
+		return func(c *Call) error {
+			err := next(c)
+			call = *c
+			return err
+		}
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "rest/api/2/serverInfo", nil)
+	testClient.Do(req, nil)
+	if call.Operation != "" || call.PathTemplate != "/rest/api/2/serverInfo" {
+		t.Errorf("Unexpected call: %+v", call)
+	}
+}
+
+func TestClient_Use_NoResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testClient.Use(func(next Handler) Handler {
+		return func(call *Call) error {
+			// Short-circuit without sending the request
+			return nil
+		}
+	})
+
+	_, _, err := testClient.Issue.Get(context.Background(), "10002", nil)
+	if err == nil || !strings.Contains(err.Error(), "middleware returned no response") {
+		t.Errorf("Expected an error for a missing response. Got %v", err)
+	}
+}
+
+func TestPathTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/rest/api/2/issue/EX-1":                     "/rest/api/2/issue/{id}",
+		"/rest/api/3/issue/10002/comment/10000":      "/rest/api/3/issue/{id}/comment/{id}",
+		"/rest/api/2/project/EX/role/10002":          "/rest/api/2/project/{id}/role/{id}",
+		"/rest/api/2/issueLinkType":                  "/rest/api/2/issueLinkType",
+		"/rest/api/2/group/member":                   "/rest/api/2/group/member",
+		"/rest/agile/1.0/board/1/sprint":             "/rest/agile/1.0/board/{id}/sprint",
+		"/rest/servicedeskapi/request/SD-1/comment":  "/rest/servicedeskapi/request/{id}/comment",
+		"/rest/api/2/field/customfield_10001/option": "/rest/api/2/field/{id}/option",
+		"/rest/pat/latest/tokens/4":                  "/rest/pat/latest/tokens/{id}",
+	}
+	for path, want := range tests {
+		if got := pathTemplate("/", path); got != want {
+			t.Errorf("pathTemplate(%q) = %q, want %q", path, got, want)
+		}
+	}
+	if got := pathTemplate("/jira/", "/jira/rest/api/2/issue/EX-1"); got != "/rest/api/2/issue/{id}" {
+		t.Errorf("Expected the base path to be removed. Got %q", got)
+	}
+}
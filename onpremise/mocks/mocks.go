@@ -0,0 +1,1378 @@
+// Code generated by servicegen. DO NOT EDIT.
+
+// Package mocks provides mocks of the services of the onpremise package.
+//
+// A mock calls the function of the field named like the method with the suffix "Func".
+// Calling a method without a function panics.
+package mocks
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/conductorone/go-jira/v2/onpremise"
+)
+
+// ApplicationRoleService is a mock of onpremise.ApplicationRoleAPI.
+type ApplicationRoleService struct {
+	GetFunc                func(context.Context, string) (*onpremise.ApplicationRole, *onpremise.Response, error)
+	GetListFunc            func(context.Context) ([]onpremise.ApplicationRole, *onpremise.Response, error)
+	LicenseUtilizationFunc func(context.Context) ([]onpremise.LicenseUtilization, *onpremise.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *ApplicationRoleService) Get(ctx context.Context, key string) (*onpremise.ApplicationRole, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: ApplicationRoleService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, key)
+}
+
+// GetList calls GetListFunc.
+func (mock *ApplicationRoleService) GetList(ctx context.Context) ([]onpremise.ApplicationRole, *onpremise.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: ApplicationRoleService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// LicenseUtilization calls LicenseUtilizationFunc.
+func (mock *ApplicationRoleService) LicenseUtilization(ctx context.Context) ([]onpremise.LicenseUtilization, *onpremise.Response, error) {
+	if mock.LicenseUtilizationFunc == nil {
+		panic("mocks: ApplicationRoleService.LicenseUtilization is not implemented")
+	}
+	return mock.LicenseUtilizationFunc(ctx)
+}
+
+// AuthenticationService is a mock of onpremise.AuthenticationAPI.
+type AuthenticationService struct {
+	AcquireSessionCookieFunc func(context.Context, string, string) (bool, error)
+	AuthenticatedFunc        func() bool
+	GetCurrentUserFunc       func(context.Context) (*onpremise.Session, error)
+	LogoutFunc               func(context.Context) error
+	SetBasicAuthFunc         func(string, string)
+}
+
+// AcquireSessionCookie calls AcquireSessionCookieFunc.
+func (mock *AuthenticationService) AcquireSessionCookie(ctx context.Context, username string, password string) (bool, error) {
+	if mock.AcquireSessionCookieFunc == nil {
+		panic("mocks: AuthenticationService.AcquireSessionCookie is not implemented")
+	}
+	return mock.AcquireSessionCookieFunc(ctx, username, password)
+}
+
+// Authenticated calls AuthenticatedFunc.
+func (mock *AuthenticationService) Authenticated() bool {
+	if mock.AuthenticatedFunc == nil {
+		panic("mocks: AuthenticationService.Authenticated is not implemented")
+	}
+	return mock.AuthenticatedFunc()
+}
+
+// GetCurrentUser calls GetCurrentUserFunc.
+func (mock *AuthenticationService) GetCurrentUser(ctx context.Context) (*onpremise.Session, error) {
+	if mock.GetCurrentUserFunc == nil {
+		panic("mocks: AuthenticationService.GetCurrentUser is not implemented")
+	}
+	return mock.GetCurrentUserFunc(ctx)
+}
+
+// Logout calls LogoutFunc.
+func (mock *AuthenticationService) Logout(ctx context.Context) error {
+	if mock.LogoutFunc == nil {
+		panic("mocks: AuthenticationService.Logout is not implemented")
+	}
+	return mock.LogoutFunc(ctx)
+}
+
+// SetBasicAuth calls SetBasicAuthFunc.
+func (mock *AuthenticationService) SetBasicAuth(username string, password string) {
+	if mock.SetBasicAuthFunc == nil {
+		panic("mocks: AuthenticationService.SetBasicAuth is not implemented")
+	}
+	mock.SetBasicAuthFunc(username, password)
+}
+
+// BoardService is a mock of onpremise.BoardAPI.
+type BoardService struct {
+	CreateBoardFunc           func(context.Context, *onpremise.Board) (*onpremise.Board, *onpremise.Response, error)
+	DeleteBoardFunc           func(context.Context, int) (*onpremise.Board, *onpremise.Response, error)
+	GetAllBoardsFunc          func(context.Context, *onpremise.BoardListOptions) (*onpremise.BoardsList, *onpremise.Response, error)
+	GetAllSprintsFunc         func(context.Context, int, *onpremise.GetAllSprintsOptions) (*onpremise.SprintsList, *onpremise.Response, error)
+	GetBoardFunc              func(context.Context, int) (*onpremise.Board, *onpremise.Response, error)
+	GetBoardConfigurationFunc func(context.Context, int) (*onpremise.BoardConfiguration, *onpremise.Response, error)
+}
+
+// CreateBoard calls CreateBoardFunc.
+func (mock *BoardService) CreateBoard(ctx context.Context, board *onpremise.Board) (*onpremise.Board, *onpremise.Response, error) {
+	if mock.CreateBoardFunc == nil {
+		panic("mocks: BoardService.CreateBoard is not implemented")
+	}
+	return mock.CreateBoardFunc(ctx, board)
+}
+
+// DeleteBoard calls DeleteBoardFunc.
+func (mock *BoardService) DeleteBoard(ctx context.Context, boardID int) (*onpremise.Board, *onpremise.Response, error) {
+	if mock.DeleteBoardFunc == nil {
+		panic("mocks: BoardService.DeleteBoard is not implemented")
+	}
+	return mock.DeleteBoardFunc(ctx, boardID)
+}
+
+// GetAllBoards calls GetAllBoardsFunc.
+func (mock *BoardService) GetAllBoards(ctx context.Context, opt *onpremise.BoardListOptions) (*onpremise.BoardsList, *onpremise.Response, error) {
+	if mock.GetAllBoardsFunc == nil {
+		panic("mocks: BoardService.GetAllBoards is not implemented")
+	}
+	return mock.GetAllBoardsFunc(ctx, opt)
+}
+
+// GetAllSprints calls GetAllSprintsFunc.
+func (mock *BoardService) GetAllSprints(ctx context.Context, boardID int, options *onpremise.GetAllSprintsOptions) (*onpremise.SprintsList, *onpremise.Response, error) {
+	if mock.GetAllSprintsFunc == nil {
+		panic("mocks: BoardService.GetAllSprints is not implemented")
+	}
+	return mock.GetAllSprintsFunc(ctx, boardID, options)
+}
+
+// GetBoard calls GetBoardFunc.
+func (mock *BoardService) GetBoard(ctx context.Context, boardID int) (*onpremise.Board, *onpremise.Response, error) {
+	if mock.GetBoardFunc == nil {
+		panic("mocks: BoardService.GetBoard is not implemented")
+	}
+	return mock.GetBoardFunc(ctx, boardID)
+}
+
+// GetBoardConfiguration calls GetBoardConfigurationFunc.
+func (mock *BoardService) GetBoardConfiguration(ctx context.Context, boardID int) (*onpremise.BoardConfiguration, *onpremise.Response, error) {
+	if mock.GetBoardConfigurationFunc == nil {
+		panic("mocks: BoardService.GetBoardConfiguration is not implemented")
+	}
+	return mock.GetBoardConfigurationFunc(ctx, boardID)
+}
+
+// ComponentService is a mock of onpremise.ComponentAPI.
+type ComponentService struct {
+	CreateFunc func(context.Context, *onpremise.CreateComponentOptions) (*onpremise.ProjectComponent, *onpremise.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *ComponentService) Create(ctx context.Context, options *onpremise.CreateComponentOptions) (*onpremise.ProjectComponent, *onpremise.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: ComponentService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, options)
+}
+
+// CustomerService is a mock of onpremise.CustomerAPI.
+type CustomerService struct {
+	CreateFunc func(context.Context, string, string) (*onpremise.Customer, *onpremise.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *CustomerService) Create(ctx context.Context, email string, displayName string) (*onpremise.Customer, *onpremise.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: CustomerService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, email, displayName)
+}
+
+// FieldService is a mock of onpremise.FieldAPI.
+type FieldService struct {
+	GetListFunc func(context.Context) ([]onpremise.Field, *onpremise.Response, error)
+}
+
+// GetList calls GetListFunc.
+func (mock *FieldService) GetList(ctx context.Context) ([]onpremise.Field, *onpremise.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: FieldService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// FilterService is a mock of onpremise.FilterAPI.
+type FilterService struct {
+	GetFunc              func(context.Context, int) (*onpremise.Filter, *onpremise.Response, error)
+	GetFavouriteListFunc func(context.Context) ([]*onpremise.Filter, *onpremise.Response, error)
+	GetListFunc          func(context.Context) ([]*onpremise.Filter, *onpremise.Response, error)
+	GetMyFiltersFunc     func(context.Context, *onpremise.GetMyFiltersQueryOptions) ([]*onpremise.Filter, *onpremise.Response, error)
+	SearchFunc           func(context.Context, *onpremise.FilterSearchOptions) (*onpremise.FiltersList, *onpremise.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *FilterService) Get(ctx context.Context, filterID int) (*onpremise.Filter, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: FilterService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, filterID)
+}
+
+// GetFavouriteList calls GetFavouriteListFunc.
+func (mock *FilterService) GetFavouriteList(ctx context.Context) ([]*onpremise.Filter, *onpremise.Response, error) {
+	if mock.GetFavouriteListFunc == nil {
+		panic("mocks: FilterService.GetFavouriteList is not implemented")
+	}
+	return mock.GetFavouriteListFunc(ctx)
+}
+
+// GetList calls GetListFunc.
+func (mock *FilterService) GetList(ctx context.Context) ([]*onpremise.Filter, *onpremise.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: FilterService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// GetMyFilters calls GetMyFiltersFunc.
+func (mock *FilterService) GetMyFilters(ctx context.Context, opts *onpremise.GetMyFiltersQueryOptions) ([]*onpremise.Filter, *onpremise.Response, error) {
+	if mock.GetMyFiltersFunc == nil {
+		panic("mocks: FilterService.GetMyFilters is not implemented")
+	}
+	return mock.GetMyFiltersFunc(ctx, opts)
+}
+
+// Search calls SearchFunc.
+func (mock *FilterService) Search(ctx context.Context, opt *onpremise.FilterSearchOptions) (*onpremise.FiltersList, *onpremise.Response, error) {
+	if mock.SearchFunc == nil {
+		panic("mocks: FilterService.Search is not implemented")
+	}
+	return mock.SearchFunc(ctx, opt)
+}
+
+// GroupService is a mock of onpremise.GroupAPI.
+type GroupService struct {
+	AddFunc    func(context.Context, string, string) (*onpremise.Group, *onpremise.Response, error)
+	GetFunc    func(context.Context, string, *onpremise.GroupSearchOptions) ([]onpremise.GroupMember, *onpremise.Response, error)
+	RemoveFunc func(context.Context, string, string) (*onpremise.Response, error)
+}
+
+// Add calls AddFunc.
+func (mock *GroupService) Add(ctx context.Context, groupname string, username string) (*onpremise.Group, *onpremise.Response, error) {
+	if mock.AddFunc == nil {
+		panic("mocks: GroupService.Add is not implemented")
+	}
+	return mock.AddFunc(ctx, groupname, username)
+}
+
+// Get calls GetFunc.
+func (mock *GroupService) Get(ctx context.Context, name string, options *onpremise.GroupSearchOptions) ([]onpremise.GroupMember, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: GroupService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, name, options)
+}
+
+// Remove calls RemoveFunc.
+func (mock *GroupService) Remove(ctx context.Context, groupname string, username string) (*onpremise.Response, error) {
+	if mock.RemoveFunc == nil {
+		panic("mocks: GroupService.Remove is not implemented")
+	}
+	return mock.RemoveFunc(ctx, groupname, username)
+}
+
+// IssueLinkTypeService is a mock of onpremise.IssueLinkTypeAPI.
+type IssueLinkTypeService struct {
+	CreateFunc  func(context.Context, *onpremise.IssueLinkType) (*onpremise.IssueLinkType, *onpremise.Response, error)
+	DeleteFunc  func(context.Context, string) (*onpremise.Response, error)
+	GetFunc     func(context.Context, string) (*onpremise.IssueLinkType, *onpremise.Response, error)
+	GetListFunc func(context.Context) ([]onpremise.IssueLinkType, *onpremise.Response, error)
+	UpdateFunc  func(context.Context, *onpremise.IssueLinkType) (*onpremise.IssueLinkType, *onpremise.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *IssueLinkTypeService) Create(ctx context.Context, linkType *onpremise.IssueLinkType) (*onpremise.IssueLinkType, *onpremise.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: IssueLinkTypeService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, linkType)
+}
+
+// Delete calls DeleteFunc.
+func (mock *IssueLinkTypeService) Delete(ctx context.Context, ID string) (*onpremise.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: IssueLinkTypeService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, ID)
+}
+
+// Get calls GetFunc.
+func (mock *IssueLinkTypeService) Get(ctx context.Context, ID string) (*onpremise.IssueLinkType, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: IssueLinkTypeService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, ID)
+}
+
+// GetList calls GetListFunc.
+func (mock *IssueLinkTypeService) GetList(ctx context.Context) ([]onpremise.IssueLinkType, *onpremise.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: IssueLinkTypeService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// Update calls UpdateFunc.
+func (mock *IssueLinkTypeService) Update(ctx context.Context, linkType *onpremise.IssueLinkType) (*onpremise.IssueLinkType, *onpremise.Response, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: IssueLinkTypeService.Update is not implemented")
+	}
+	return mock.UpdateFunc(ctx, linkType)
+}
+
+// IssueService is a mock of onpremise.IssueAPI.
+type IssueService struct {
+	AddCommentFunc                     func(context.Context, string, *onpremise.Comment) (*onpremise.Comment, *onpremise.Response, error)
+	AddLinkFunc                        func(context.Context, *onpremise.IssueLink) (*onpremise.Response, error)
+	AddRemoteLinkFunc                  func(context.Context, string, *onpremise.RemoteLink) (*onpremise.RemoteLink, *onpremise.Response, error)
+	AddWatcherFunc                     func(context.Context, string, string) (*onpremise.Response, error)
+	AddWorklogRecordFunc               func(context.Context, string, *onpremise.WorklogRecord, ...func(*http.Request) error) (*onpremise.WorklogRecord, *onpremise.Response, error)
+	CreateFunc                         func(context.Context, *onpremise.Issue) (*onpremise.Issue, *onpremise.Response, error)
+	DeleteFunc                         func(context.Context, string) (*onpremise.Response, error)
+	DeleteAttachmentFunc               func(context.Context, string) (*onpremise.Response, error)
+	DeleteCommentFunc                  func(context.Context, string, string) error
+	DeleteLinkFunc                     func(context.Context, string) (*onpremise.Response, error)
+	DoTransitionFunc                   func(context.Context, string, string) (*onpremise.Response, error)
+	DoTransitionWithPayloadFunc        func(context.Context, interface{}, interface{}) (*onpremise.Response, error)
+	DownloadAttachmentFunc             func(context.Context, string) (*onpremise.Response, error)
+	DownloadAttachmentToFunc           func(context.Context, string, io.Writer, *onpremise.DownloadAttachmentOptions) (*onpremise.Attachment, *onpremise.Response, error)
+	GetFunc                            func(context.Context, string, *onpremise.GetQueryOptions) (*onpremise.Issue, *onpremise.Response, error)
+	GetAttachmentFunc                  func(context.Context, string) (*onpremise.Attachment, *onpremise.Response, error)
+	GetCreateMetaFunc                  func(context.Context, *onpremise.GetQueryOptions) (*onpremise.CreateMetaInfo, *onpremise.Response, error)
+	GetCreateMetaIssueTypeFunc         func(context.Context, string, string, *onpremise.GetQueryIssueTypeOptions) ([]*onpremise.MetaDataFields, *onpremise.Response, bool, error)
+	GetCreateMetaProjectIssueTypesFunc func(context.Context, string, *onpremise.GetQueryIssueTypeOptions) ([]*onpremise.MetaIssueType, *onpremise.Response, bool, error)
+	GetCustomFieldsFunc                func(context.Context, string) (onpremise.CustomFields, *onpremise.Response, error)
+	GetEditMetaFunc                    func(context.Context, *onpremise.Issue) (*onpremise.EditMetaInfo, *onpremise.Response, error)
+	GetRemoteLinksFunc                 func(context.Context, string) (*[]onpremise.RemoteLink, *onpremise.Response, error)
+	GetTransitionsFunc                 func(context.Context, string) ([]onpremise.Transition, *onpremise.Response, error)
+	GetWatchersFunc                    func(context.Context, string) (*[]onpremise.User, *onpremise.Response, error)
+	GetWorklogsFunc                    func(context.Context, string, ...func(*http.Request) error) (*onpremise.Worklog, *onpremise.Response, error)
+	PostAttachmentFunc                 func(context.Context, string, io.Reader, string) (*[]onpremise.Attachment, *onpremise.Response, error)
+	PostAttachmentsFunc                func(context.Context, string, []onpremise.AttachmentFile, *onpremise.PostAttachmentOptions) (*[]onpremise.Attachment, *onpremise.Response, error)
+	RemoveWatcherFunc                  func(context.Context, string, string) (*onpremise.Response, error)
+	SearchFunc                         func(context.Context, string, *onpremise.SearchOptions) ([]onpremise.Issue, *onpremise.Response, error)
+	SearchPagesFunc                    func(context.Context, string, *onpremise.SearchOptions, func(onpremise.Issue) error) error
+	UpdateFunc                         func(context.Context, *onpremise.Issue, *onpremise.UpdateQueryOptions) (*onpremise.Issue, *onpremise.Response, error)
+	UpdateAssigneeFunc                 func(context.Context, string, *onpremise.User) (*onpremise.Response, error)
+	UpdateCommentFunc                  func(context.Context, string, *onpremise.Comment) (*onpremise.Comment, *onpremise.Response, error)
+	UpdateIssueFunc                    func(context.Context, string, map[string]interface{}) (*onpremise.Response, error)
+	UpdateRemoteLinkFunc               func(context.Context, string, int, *onpremise.RemoteLink) (*onpremise.Response, error)
+	UpdateWorklogRecordFunc            func(context.Context, string, string, *onpremise.WorklogRecord, ...func(*http.Request) error) (*onpremise.WorklogRecord, *onpremise.Response, error)
+	UpsertByExternalIDFunc             func(context.Context, string, string, *onpremise.Issue, ...onpremise.UpsertOption) (*onpremise.UpsertResult, *onpremise.Response, error)
+}
+
+// AddComment calls AddCommentFunc.
+func (mock *IssueService) AddComment(ctx context.Context, issueID string, comment *onpremise.Comment) (*onpremise.Comment, *onpremise.Response, error) {
+	if mock.AddCommentFunc == nil {
+		panic("mocks: IssueService.AddComment is not implemented")
+	}
+	return mock.AddCommentFunc(ctx, issueID, comment)
+}
+
+// AddLink calls AddLinkFunc.
+func (mock *IssueService) AddLink(ctx context.Context, issueLink *onpremise.IssueLink) (*onpremise.Response, error) {
+	if mock.AddLinkFunc == nil {
+		panic("mocks: IssueService.AddLink is not implemented")
+	}
+	return mock.AddLinkFunc(ctx, issueLink)
+}
+
+// AddRemoteLink calls AddRemoteLinkFunc.
+func (mock *IssueService) AddRemoteLink(ctx context.Context, issueID string, remotelink *onpremise.RemoteLink) (*onpremise.RemoteLink, *onpremise.Response, error) {
+	if mock.AddRemoteLinkFunc == nil {
+		panic("mocks: IssueService.AddRemoteLink is not implemented")
+	}
+	return mock.AddRemoteLinkFunc(ctx, issueID, remotelink)
+}
+
+// AddWatcher calls AddWatcherFunc.
+func (mock *IssueService) AddWatcher(ctx context.Context, issueID string, userName string) (*onpremise.Response, error) {
+	if mock.AddWatcherFunc == nil {
+		panic("mocks: IssueService.AddWatcher is not implemented")
+	}
+	return mock.AddWatcherFunc(ctx, issueID, userName)
+}
+
+// AddWorklogRecord calls AddWorklogRecordFunc.
+func (mock *IssueService) AddWorklogRecord(ctx context.Context, issueID string, record *onpremise.WorklogRecord, options ...func(*http.Request) error) (*onpremise.WorklogRecord, *onpremise.Response, error) {
+	if mock.AddWorklogRecordFunc == nil {
+		panic("mocks: IssueService.AddWorklogRecord is not implemented")
+	}
+	return mock.AddWorklogRecordFunc(ctx, issueID, record, options...)
+}
+
+// Create calls CreateFunc.
+func (mock *IssueService) Create(ctx context.Context, issue *onpremise.Issue) (*onpremise.Issue, *onpremise.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: IssueService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, issue)
+}
+
+// Delete calls DeleteFunc.
+func (mock *IssueService) Delete(ctx context.Context, issueID string) (*onpremise.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: IssueService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, issueID)
+}
+
+// DeleteAttachment calls DeleteAttachmentFunc.
+func (mock *IssueService) DeleteAttachment(ctx context.Context, attachmentID string) (*onpremise.Response, error) {
+	if mock.DeleteAttachmentFunc == nil {
+		panic("mocks: IssueService.DeleteAttachment is not implemented")
+	}
+	return mock.DeleteAttachmentFunc(ctx, attachmentID)
+}
+
+// DeleteComment calls DeleteCommentFunc.
+func (mock *IssueService) DeleteComment(ctx context.Context, issueID string, commentID string) error {
+	if mock.DeleteCommentFunc == nil {
+		panic("mocks: IssueService.DeleteComment is not implemented")
+	}
+	return mock.DeleteCommentFunc(ctx, issueID, commentID)
+}
+
+// DeleteLink calls DeleteLinkFunc.
+func (mock *IssueService) DeleteLink(ctx context.Context, linkID string) (*onpremise.Response, error) {
+	if mock.DeleteLinkFunc == nil {
+		panic("mocks: IssueService.DeleteLink is not implemented")
+	}
+	return mock.DeleteLinkFunc(ctx, linkID)
+}
+
+// DoTransition calls DoTransitionFunc.
+func (mock *IssueService) DoTransition(ctx context.Context, ticketID string, transitionID string) (*onpremise.Response, error) {
+	if mock.DoTransitionFunc == nil {
+		panic("mocks: IssueService.DoTransition is not implemented")
+	}
+	return mock.DoTransitionFunc(ctx, ticketID, transitionID)
+}
+
+// DoTransitionWithPayload calls DoTransitionWithPayloadFunc.
+func (mock *IssueService) DoTransitionWithPayload(ctx context.Context, ticketID interface{}, payload interface{}) (*onpremise.Response, error) {
+	if mock.DoTransitionWithPayloadFunc == nil {
+		panic("mocks: IssueService.DoTransitionWithPayload is not implemented")
+	}
+	return mock.DoTransitionWithPayloadFunc(ctx, ticketID, payload)
+}
+
+// DownloadAttachment calls DownloadAttachmentFunc.
+func (mock *IssueService) DownloadAttachment(ctx context.Context, attachmentID string) (*onpremise.Response, error) {
+	if mock.DownloadAttachmentFunc == nil {
+		panic("mocks: IssueService.DownloadAttachment is not implemented")
+	}
+	return mock.DownloadAttachmentFunc(ctx, attachmentID)
+}
+
+// DownloadAttachmentTo calls DownloadAttachmentToFunc.
+func (mock *IssueService) DownloadAttachmentTo(ctx context.Context, attachmentID string, w io.Writer, options *onpremise.DownloadAttachmentOptions) (*onpremise.Attachment, *onpremise.Response, error) {
+	if mock.DownloadAttachmentToFunc == nil {
+		panic("mocks: IssueService.DownloadAttachmentTo is not implemented")
+	}
+	return mock.DownloadAttachmentToFunc(ctx, attachmentID, w, options)
+}
+
+// Get calls GetFunc.
+func (mock *IssueService) Get(ctx context.Context, issueID string, options *onpremise.GetQueryOptions) (*onpremise.Issue, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: IssueService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, issueID, options)
+}
+
+// GetAttachment calls GetAttachmentFunc.
+func (mock *IssueService) GetAttachment(ctx context.Context, attachmentID string) (*onpremise.Attachment, *onpremise.Response, error) {
+	if mock.GetAttachmentFunc == nil {
+		panic("mocks: IssueService.GetAttachment is not implemented")
+	}
+	return mock.GetAttachmentFunc(ctx, attachmentID)
+}
+
+// GetCreateMeta calls GetCreateMetaFunc.
+func (mock *IssueService) GetCreateMeta(ctx context.Context, options *onpremise.GetQueryOptions) (*onpremise.CreateMetaInfo, *onpremise.Response, error) {
+	if mock.GetCreateMetaFunc == nil {
+		panic("mocks: IssueService.GetCreateMeta is not implemented")
+	}
+	return mock.GetCreateMetaFunc(ctx, options)
+}
+
+// GetCreateMetaIssueType calls GetCreateMetaIssueTypeFunc.
+func (mock *IssueService) GetCreateMetaIssueType(ctx context.Context, projectKey string, issueTypeId string, options *onpremise.GetQueryIssueTypeOptions) ([]*onpremise.MetaDataFields, *onpremise.Response, bool, error) {
+	if mock.GetCreateMetaIssueTypeFunc == nil {
+		panic("mocks: IssueService.GetCreateMetaIssueType is not implemented")
+	}
+	return mock.GetCreateMetaIssueTypeFunc(ctx, projectKey, issueTypeId, options)
+}
+
+// GetCreateMetaProjectIssueTypes calls GetCreateMetaProjectIssueTypesFunc.
+func (mock *IssueService) GetCreateMetaProjectIssueTypes(ctx context.Context, projectKey string, options *onpremise.GetQueryIssueTypeOptions) ([]*onpremise.MetaIssueType, *onpremise.Response, bool, error) {
+	if mock.GetCreateMetaProjectIssueTypesFunc == nil {
+		panic("mocks: IssueService.GetCreateMetaProjectIssueTypes is not implemented")
+	}
+	return mock.GetCreateMetaProjectIssueTypesFunc(ctx, projectKey, options)
+}
+
+// GetCustomFields calls GetCustomFieldsFunc.
+func (mock *IssueService) GetCustomFields(ctx context.Context, issueID string) (onpremise.CustomFields, *onpremise.Response, error) {
+	if mock.GetCustomFieldsFunc == nil {
+		panic("mocks: IssueService.GetCustomFields is not implemented")
+	}
+	return mock.GetCustomFieldsFunc(ctx, issueID)
+}
+
+// GetEditMeta calls GetEditMetaFunc.
+func (mock *IssueService) GetEditMeta(ctx context.Context, issue *onpremise.Issue) (*onpremise.EditMetaInfo, *onpremise.Response, error) {
+	if mock.GetEditMetaFunc == nil {
+		panic("mocks: IssueService.GetEditMeta is not implemented")
+	}
+	return mock.GetEditMetaFunc(ctx, issue)
+}
+
+// GetRemoteLinks calls GetRemoteLinksFunc.
+func (mock *IssueService) GetRemoteLinks(ctx context.Context, id string) (*[]onpremise.RemoteLink, *onpremise.Response, error) {
+	if mock.GetRemoteLinksFunc == nil {
+		panic("mocks: IssueService.GetRemoteLinks is not implemented")
+	}
+	return mock.GetRemoteLinksFunc(ctx, id)
+}
+
+// GetTransitions calls GetTransitionsFunc.
+func (mock *IssueService) GetTransitions(ctx context.Context, id string) ([]onpremise.Transition, *onpremise.Response, error) {
+	if mock.GetTransitionsFunc == nil {
+		panic("mocks: IssueService.GetTransitions is not implemented")
+	}
+	return mock.GetTransitionsFunc(ctx, id)
+}
+
+// GetWatchers calls GetWatchersFunc.
+func (mock *IssueService) GetWatchers(ctx context.Context, issueID string) (*[]onpremise.User, *onpremise.Response, error) {
+	if mock.GetWatchersFunc == nil {
+		panic("mocks: IssueService.GetWatchers is not implemented")
+	}
+	return mock.GetWatchersFunc(ctx, issueID)
+}
+
+// GetWorklogs calls GetWorklogsFunc.
+func (mock *IssueService) GetWorklogs(ctx context.Context, issueID string, options ...func(*http.Request) error) (*onpremise.Worklog, *onpremise.Response, error) {
+	if mock.GetWorklogsFunc == nil {
+		panic("mocks: IssueService.GetWorklogs is not implemented")
+	}
+	return mock.GetWorklogsFunc(ctx, issueID, options...)
+}
+
+// PostAttachment calls PostAttachmentFunc.
+func (mock *IssueService) PostAttachment(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]onpremise.Attachment, *onpremise.Response, error) {
+	if mock.PostAttachmentFunc == nil {
+		panic("mocks: IssueService.PostAttachment is not implemented")
+	}
+	return mock.PostAttachmentFunc(ctx, issueID, r, attachmentName)
+}
+
+// PostAttachments calls PostAttachmentsFunc.
+func (mock *IssueService) PostAttachments(ctx context.Context, issueID string, files []onpremise.AttachmentFile, options *onpremise.PostAttachmentOptions) (*[]onpremise.Attachment, *onpremise.Response, error) {
+	if mock.PostAttachmentsFunc == nil {
+		panic("mocks: IssueService.PostAttachments is not implemented")
+	}
+	return mock.PostAttachmentsFunc(ctx, issueID, files, options)
+}
+
+// RemoveWatcher calls RemoveWatcherFunc.
+func (mock *IssueService) RemoveWatcher(ctx context.Context, issueID string, userName string) (*onpremise.Response, error) {
+	if mock.RemoveWatcherFunc == nil {
+		panic("mocks: IssueService.RemoveWatcher is not implemented")
+	}
+	return mock.RemoveWatcherFunc(ctx, issueID, userName)
+}
+
+// Search calls SearchFunc.
+func (mock *IssueService) Search(ctx context.Context, jql string, options *onpremise.SearchOptions) ([]onpremise.Issue, *onpremise.Response, error) {
+	if mock.SearchFunc == nil {
+		panic("mocks: IssueService.Search is not implemented")
+	}
+	return mock.SearchFunc(ctx, jql, options)
+}
+
+// SearchPages calls SearchPagesFunc.
+func (mock *IssueService) SearchPages(ctx context.Context, jql string, options *onpremise.SearchOptions, f func(onpremise.Issue) error) error {
+	if mock.SearchPagesFunc == nil {
+		panic("mocks: IssueService.SearchPages is not implemented")
+	}
+	return mock.SearchPagesFunc(ctx, jql, options, f)
+}
+
+// Update calls UpdateFunc.
+func (mock *IssueService) Update(ctx context.Context, issue *onpremise.Issue, opts *onpremise.UpdateQueryOptions) (*onpremise.Issue, *onpremise.Response, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: IssueService.Update is not implemented")
+	}
+	return mock.UpdateFunc(ctx, issue, opts)
+}
+
+// UpdateAssignee calls UpdateAssigneeFunc.
+func (mock *IssueService) UpdateAssignee(ctx context.Context, issueID string, assignee *onpremise.User) (*onpremise.Response, error) {
+	if mock.UpdateAssigneeFunc == nil {
+		panic("mocks: IssueService.UpdateAssignee is not implemented")
+	}
+	return mock.UpdateAssigneeFunc(ctx, issueID, assignee)
+}
+
+// UpdateComment calls UpdateCommentFunc.
+func (mock *IssueService) UpdateComment(ctx context.Context, issueID string, comment *onpremise.Comment) (*onpremise.Comment, *onpremise.Response, error) {
+	if mock.UpdateCommentFunc == nil {
+		panic("mocks: IssueService.UpdateComment is not implemented")
+	}
+	return mock.UpdateCommentFunc(ctx, issueID, comment)
+}
+
+// UpdateIssue calls UpdateIssueFunc.
+func (mock *IssueService) UpdateIssue(ctx context.Context, jiraID string, data map[string]interface{}) (*onpremise.Response, error) {
+	if mock.UpdateIssueFunc == nil {
+		panic("mocks: IssueService.UpdateIssue is not implemented")
+	}
+	return mock.UpdateIssueFunc(ctx, jiraID, data)
+}
+
+// UpdateRemoteLink calls UpdateRemoteLinkFunc.
+func (mock *IssueService) UpdateRemoteLink(ctx context.Context, issueID string, linkID int, remotelink *onpremise.RemoteLink) (*onpremise.Response, error) {
+	if mock.UpdateRemoteLinkFunc == nil {
+		panic("mocks: IssueService.UpdateRemoteLink is not implemented")
+	}
+	return mock.UpdateRemoteLinkFunc(ctx, issueID, linkID, remotelink)
+}
+
+// UpdateWorklogRecord calls UpdateWorklogRecordFunc.
+func (mock *IssueService) UpdateWorklogRecord(ctx context.Context, issueID string, worklogID string, record *onpremise.WorklogRecord, options ...func(*http.Request) error) (*onpremise.WorklogRecord, *onpremise.Response, error) {
+	if mock.UpdateWorklogRecordFunc == nil {
+		panic("mocks: IssueService.UpdateWorklogRecord is not implemented")
+	}
+	return mock.UpdateWorklogRecordFunc(ctx, issueID, worklogID, record, options...)
+}
+
+// UpsertByExternalID calls UpsertByExternalIDFunc.
+func (mock *IssueService) UpsertByExternalID(ctx context.Context, project string, externalID string, issue *onpremise.Issue, options ...onpremise.UpsertOption) (*onpremise.UpsertResult, *onpremise.Response, error) {
+	if mock.UpsertByExternalIDFunc == nil {
+		panic("mocks: IssueService.UpsertByExternalID is not implemented")
+	}
+	return mock.UpsertByExternalIDFunc(ctx, project, externalID, issue, options...)
+}
+
+// OrganizationService is a mock of onpremise.OrganizationAPI.
+type OrganizationService struct {
+	AddUsersFunc            func(context.Context, int, onpremise.OrganizationUsersDTO) (*onpremise.Response, error)
+	CreateOrganizationFunc  func(context.Context, string) (*onpremise.Organization, *onpremise.Response, error)
+	DeleteOrganizationFunc  func(context.Context, int) (*onpremise.Response, error)
+	DeletePropertyFunc      func(context.Context, int, string) (*onpremise.Response, error)
+	GetAllOrganizationsFunc func(context.Context, int, int, string) (*onpremise.PagedDTO, *onpremise.Response, error)
+	GetOrganizationFunc     func(context.Context, int) (*onpremise.Organization, *onpremise.Response, error)
+	GetPropertiesKeysFunc   func(context.Context, int) (*onpremise.PropertyKeys, *onpremise.Response, error)
+	GetPropertyFunc         func(context.Context, int, string) (*onpremise.EntityProperty, *onpremise.Response, error)
+	GetUsersFunc            func(context.Context, int, int, int) (*onpremise.PagedDTO, *onpremise.Response, error)
+	RemoveUsersFunc         func(context.Context, int, onpremise.OrganizationUsersDTO) (*onpremise.Response, error)
+	SetPropertyFunc         func(context.Context, int, string, interface{}) (*onpremise.Response, error)
+}
+
+// AddUsers calls AddUsersFunc.
+func (mock *OrganizationService) AddUsers(ctx context.Context, organizationID int, users onpremise.OrganizationUsersDTO) (*onpremise.Response, error) {
+	if mock.AddUsersFunc == nil {
+		panic("mocks: OrganizationService.AddUsers is not implemented")
+	}
+	return mock.AddUsersFunc(ctx, organizationID, users)
+}
+
+// CreateOrganization calls CreateOrganizationFunc.
+func (mock *OrganizationService) CreateOrganization(ctx context.Context, name string) (*onpremise.Organization, *onpremise.Response, error) {
+	if mock.CreateOrganizationFunc == nil {
+		panic("mocks: OrganizationService.CreateOrganization is not implemented")
+	}
+	return mock.CreateOrganizationFunc(ctx, name)
+}
+
+// DeleteOrganization calls DeleteOrganizationFunc.
+func (mock *OrganizationService) DeleteOrganization(ctx context.Context, organizationID int) (*onpremise.Response, error) {
+	if mock.DeleteOrganizationFunc == nil {
+		panic("mocks: OrganizationService.DeleteOrganization is not implemented")
+	}
+	return mock.DeleteOrganizationFunc(ctx, organizationID)
+}
+
+// DeleteProperty calls DeletePropertyFunc.
+func (mock *OrganizationService) DeleteProperty(ctx context.Context, organizationID int, propertyKey string) (*onpremise.Response, error) {
+	if mock.DeletePropertyFunc == nil {
+		panic("mocks: OrganizationService.DeleteProperty is not implemented")
+	}
+	return mock.DeletePropertyFunc(ctx, organizationID, propertyKey)
+}
+
+// GetAllOrganizations calls GetAllOrganizationsFunc.
+func (mock *OrganizationService) GetAllOrganizations(ctx context.Context, start int, limit int, accountID string) (*onpremise.PagedDTO, *onpremise.Response, error) {
+	if mock.GetAllOrganizationsFunc == nil {
+		panic("mocks: OrganizationService.GetAllOrganizations is not implemented")
+	}
+	return mock.GetAllOrganizationsFunc(ctx, start, limit, accountID)
+}
+
+// GetOrganization calls GetOrganizationFunc.
+func (mock *OrganizationService) GetOrganization(ctx context.Context, organizationID int) (*onpremise.Organization, *onpremise.Response, error) {
+	if mock.GetOrganizationFunc == nil {
+		panic("mocks: OrganizationService.GetOrganization is not implemented")
+	}
+	return mock.GetOrganizationFunc(ctx, organizationID)
+}
+
+// GetPropertiesKeys calls GetPropertiesKeysFunc.
+func (mock *OrganizationService) GetPropertiesKeys(ctx context.Context, organizationID int) (*onpremise.PropertyKeys, *onpremise.Response, error) {
+	if mock.GetPropertiesKeysFunc == nil {
+		panic("mocks: OrganizationService.GetPropertiesKeys is not implemented")
+	}
+	return mock.GetPropertiesKeysFunc(ctx, organizationID)
+}
+
+// GetProperty calls GetPropertyFunc.
+func (mock *OrganizationService) GetProperty(ctx context.Context, organizationID int, propertyKey string) (*onpremise.EntityProperty, *onpremise.Response, error) {
+	if mock.GetPropertyFunc == nil {
+		panic("mocks: OrganizationService.GetProperty is not implemented")
+	}
+	return mock.GetPropertyFunc(ctx, organizationID, propertyKey)
+}
+
+// GetUsers calls GetUsersFunc.
+func (mock *OrganizationService) GetUsers(ctx context.Context, organizationID int, start int, limit int) (*onpremise.PagedDTO, *onpremise.Response, error) {
+	if mock.GetUsersFunc == nil {
+		panic("mocks: OrganizationService.GetUsers is not implemented")
+	}
+	return mock.GetUsersFunc(ctx, organizationID, start, limit)
+}
+
+// RemoveUsers calls RemoveUsersFunc.
+func (mock *OrganizationService) RemoveUsers(ctx context.Context, organizationID int, users onpremise.OrganizationUsersDTO) (*onpremise.Response, error) {
+	if mock.RemoveUsersFunc == nil {
+		panic("mocks: OrganizationService.RemoveUsers is not implemented")
+	}
+	return mock.RemoveUsersFunc(ctx, organizationID, users)
+}
+
+// SetProperty calls SetPropertyFunc.
+func (mock *OrganizationService) SetProperty(ctx context.Context, organizationID int, propertyKey string, value interface{}) (*onpremise.Response, error) {
+	if mock.SetPropertyFunc == nil {
+		panic("mocks: OrganizationService.SetProperty is not implemented")
+	}
+	return mock.SetPropertyFunc(ctx, organizationID, propertyKey, value)
+}
+
+// PATService is a mock of onpremise.PATAPI.
+type PATService struct {
+	CreateFunc         func(context.Context, *onpremise.CreatePATOptions) (*onpremise.PersonalAccessToken, *onpremise.Response, error)
+	GetListFunc        func(context.Context) ([]onpremise.PersonalAccessToken, *onpremise.Response, error)
+	GetListForUserFunc func(context.Context, string) ([]onpremise.PersonalAccessToken, *onpremise.Response, error)
+	RevokeFunc         func(context.Context, int) (*onpremise.Response, error)
+	RevokeForUserFunc  func(context.Context, string, int) (*onpremise.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *PATService) Create(ctx context.Context, options *onpremise.CreatePATOptions) (*onpremise.PersonalAccessToken, *onpremise.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: PATService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, options)
+}
+
+// GetList calls GetListFunc.
+func (mock *PATService) GetList(ctx context.Context) ([]onpremise.PersonalAccessToken, *onpremise.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: PATService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// GetListForUser calls GetListForUserFunc.
+func (mock *PATService) GetListForUser(ctx context.Context, userKey string) ([]onpremise.PersonalAccessToken, *onpremise.Response, error) {
+	if mock.GetListForUserFunc == nil {
+		panic("mocks: PATService.GetListForUser is not implemented")
+	}
+	return mock.GetListForUserFunc(ctx, userKey)
+}
+
+// Revoke calls RevokeFunc.
+func (mock *PATService) Revoke(ctx context.Context, tokenID int) (*onpremise.Response, error) {
+	if mock.RevokeFunc == nil {
+		panic("mocks: PATService.Revoke is not implemented")
+	}
+	return mock.RevokeFunc(ctx, tokenID)
+}
+
+// RevokeForUser calls RevokeForUserFunc.
+func (mock *PATService) RevokeForUser(ctx context.Context, userKey string, tokenID int) (*onpremise.Response, error) {
+	if mock.RevokeForUserFunc == nil {
+		panic("mocks: PATService.RevokeForUser is not implemented")
+	}
+	return mock.RevokeForUserFunc(ctx, userKey, tokenID)
+}
+
+// PermissionSchemeService is a mock of onpremise.PermissionSchemeAPI.
+type PermissionSchemeService struct {
+	GetFunc     func(context.Context, int) (*onpremise.PermissionScheme, *onpremise.Response, error)
+	GetListFunc func(context.Context) (*onpremise.PermissionSchemes, *onpremise.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *PermissionSchemeService) Get(ctx context.Context, schemeID int) (*onpremise.PermissionScheme, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: PermissionSchemeService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, schemeID)
+}
+
+// GetList calls GetListFunc.
+func (mock *PermissionSchemeService) GetList(ctx context.Context) (*onpremise.PermissionSchemes, *onpremise.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: PermissionSchemeService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// PriorityService is a mock of onpremise.PriorityAPI.
+type PriorityService struct {
+	GetListFunc func(context.Context) ([]onpremise.Priority, *onpremise.Response, error)
+}
+
+// GetList calls GetListFunc.
+func (mock *PriorityService) GetList(ctx context.Context) ([]onpremise.Priority, *onpremise.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: PriorityService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// ProjectCategoryService is a mock of onpremise.ProjectCategoryAPI.
+type ProjectCategoryService struct {
+	CreateFunc  func(context.Context, string, string) (*onpremise.ProjectCategory, *onpremise.Response, error)
+	DeleteFunc  func(context.Context, string) (*onpremise.Response, error)
+	GetFunc     func(context.Context, string) (*onpremise.ProjectCategory, *onpremise.Response, error)
+	GetListFunc func(context.Context) ([]onpremise.ProjectCategory, *onpremise.Response, error)
+	UpdateFunc  func(context.Context, string, string, string) (*onpremise.ProjectCategory, *onpremise.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *ProjectCategoryService) Create(ctx context.Context, name string, description string) (*onpremise.ProjectCategory, *onpremise.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: ProjectCategoryService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, name, description)
+}
+
+// Delete calls DeleteFunc.
+func (mock *ProjectCategoryService) Delete(ctx context.Context, categoryID string) (*onpremise.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: ProjectCategoryService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, categoryID)
+}
+
+// Get calls GetFunc.
+func (mock *ProjectCategoryService) Get(ctx context.Context, categoryID string) (*onpremise.ProjectCategory, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: ProjectCategoryService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, categoryID)
+}
+
+// GetList calls GetListFunc.
+func (mock *ProjectCategoryService) GetList(ctx context.Context) ([]onpremise.ProjectCategory, *onpremise.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: ProjectCategoryService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// Update calls UpdateFunc.
+func (mock *ProjectCategoryService) Update(ctx context.Context, categoryID string, name string, description string) (*onpremise.ProjectCategory, *onpremise.Response, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: ProjectCategoryService.Update is not implemented")
+	}
+	return mock.UpdateFunc(ctx, categoryID, name, description)
+}
+
+// ProjectService is a mock of onpremise.ProjectAPI.
+type ProjectService struct {
+	ArchiveFunc                   func(context.Context, string) (*onpremise.Response, error)
+	AssignIssueSecuritySchemeFunc func(context.Context, string, int) (*onpremise.Project, *onpremise.Response, error)
+	AssignNotificationSchemeFunc  func(context.Context, string, int) (*onpremise.Project, *onpremise.Response, error)
+	AssignPermissionSchemeFunc    func(context.Context, string, int) (*onpremise.PermissionScheme, *onpremise.Response, error)
+	CreateFunc                    func(context.Context, *onpremise.ProjectDetails) (*onpremise.ProjectIdentifiers, *onpremise.Response, error)
+	DeleteFunc                    func(context.Context, string) (*onpremise.Response, error)
+	GetFunc                       func(context.Context, string) (*onpremise.Project, *onpremise.Response, error)
+	GetAllFunc                    func(context.Context, *onpremise.GetQueryOptions) (*onpremise.ProjectList, *onpremise.Response, error)
+	GetPermissionSchemeFunc       func(context.Context, string) (*onpremise.PermissionScheme, *onpremise.Response, error)
+	RestoreFunc                   func(context.Context, string) (*onpremise.Response, error)
+	UpdateFunc                    func(context.Context, string, *onpremise.ProjectDetails) (*onpremise.Project, *onpremise.Response, error)
+}
+
+// Archive calls ArchiveFunc.
+func (mock *ProjectService) Archive(ctx context.Context, projectIDOrKey string) (*onpremise.Response, error) {
+	if mock.ArchiveFunc == nil {
+		panic("mocks: ProjectService.Archive is not implemented")
+	}
+	return mock.ArchiveFunc(ctx, projectIDOrKey)
+}
+
+// AssignIssueSecurityScheme calls AssignIssueSecuritySchemeFunc.
+func (mock *ProjectService) AssignIssueSecurityScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*onpremise.Project, *onpremise.Response, error) {
+	if mock.AssignIssueSecuritySchemeFunc == nil {
+		panic("mocks: ProjectService.AssignIssueSecurityScheme is not implemented")
+	}
+	return mock.AssignIssueSecuritySchemeFunc(ctx, projectIDOrKey, schemeID)
+}
+
+// AssignNotificationScheme calls AssignNotificationSchemeFunc.
+func (mock *ProjectService) AssignNotificationScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*onpremise.Project, *onpremise.Response, error) {
+	if mock.AssignNotificationSchemeFunc == nil {
+		panic("mocks: ProjectService.AssignNotificationScheme is not implemented")
+	}
+	return mock.AssignNotificationSchemeFunc(ctx, projectIDOrKey, schemeID)
+}
+
+// AssignPermissionScheme calls AssignPermissionSchemeFunc.
+func (mock *ProjectService) AssignPermissionScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*onpremise.PermissionScheme, *onpremise.Response, error) {
+	if mock.AssignPermissionSchemeFunc == nil {
+		panic("mocks: ProjectService.AssignPermissionScheme is not implemented")
+	}
+	return mock.AssignPermissionSchemeFunc(ctx, projectIDOrKey, schemeID)
+}
+
+// Create calls CreateFunc.
+func (mock *ProjectService) Create(ctx context.Context, project *onpremise.ProjectDetails) (*onpremise.ProjectIdentifiers, *onpremise.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: ProjectService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, project)
+}
+
+// Delete calls DeleteFunc.
+func (mock *ProjectService) Delete(ctx context.Context, projectIDOrKey string) (*onpremise.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: ProjectService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, projectIDOrKey)
+}
+
+// Get calls GetFunc.
+func (mock *ProjectService) Get(ctx context.Context, projectID string) (*onpremise.Project, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: ProjectService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, projectID)
+}
+
+// GetAll calls GetAllFunc.
+func (mock *ProjectService) GetAll(ctx context.Context, options *onpremise.GetQueryOptions) (*onpremise.ProjectList, *onpremise.Response, error) {
+	if mock.GetAllFunc == nil {
+		panic("mocks: ProjectService.GetAll is not implemented")
+	}
+	return mock.GetAllFunc(ctx, options)
+}
+
+// GetPermissionScheme calls GetPermissionSchemeFunc.
+func (mock *ProjectService) GetPermissionScheme(ctx context.Context, projectID string) (*onpremise.PermissionScheme, *onpremise.Response, error) {
+	if mock.GetPermissionSchemeFunc == nil {
+		panic("mocks: ProjectService.GetPermissionScheme is not implemented")
+	}
+	return mock.GetPermissionSchemeFunc(ctx, projectID)
+}
+
+// Restore calls RestoreFunc.
+func (mock *ProjectService) Restore(ctx context.Context, projectIDOrKey string) (*onpremise.Response, error) {
+	if mock.RestoreFunc == nil {
+		panic("mocks: ProjectService.Restore is not implemented")
+	}
+	return mock.RestoreFunc(ctx, projectIDOrKey)
+}
+
+// Update calls UpdateFunc.
+func (mock *ProjectService) Update(ctx context.Context, projectIDOrKey string, project *onpremise.ProjectDetails) (*onpremise.Project, *onpremise.Response, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: ProjectService.Update is not implemented")
+	}
+	return mock.UpdateFunc(ctx, projectIDOrKey, project)
+}
+
+// PropertyService is a mock of onpremise.PropertyAPI.
+type PropertyService struct {
+	DeleteFunc      func(context.Context, onpremise.PropertyEntity, string, string) (*onpremise.Response, error)
+	GetFunc         func(context.Context, onpremise.PropertyEntity, string, string) (*onpremise.Property, *onpremise.Response, error)
+	GetKeysFunc     func(context.Context, onpremise.PropertyEntity, string) ([]onpremise.PropertyKey, *onpremise.Response, error)
+	SetFunc         func(context.Context, onpremise.PropertyEntity, string, string, interface{}) (*onpremise.Response, error)
+	SetOnIssuesFunc func(context.Context, string, interface{}, *onpremise.BulkPropertyFilter) (*onpremise.Response, error)
+}
+
+// Delete calls DeleteFunc.
+func (mock *PropertyService) Delete(ctx context.Context, entity onpremise.PropertyEntity, entityID string, key string) (*onpremise.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: PropertyService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, entity, entityID, key)
+}
+
+// Get calls GetFunc.
+func (mock *PropertyService) Get(ctx context.Context, entity onpremise.PropertyEntity, entityID string, key string) (*onpremise.Property, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: PropertyService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, entity, entityID, key)
+}
+
+// GetKeys calls GetKeysFunc.
+func (mock *PropertyService) GetKeys(ctx context.Context, entity onpremise.PropertyEntity, entityID string) ([]onpremise.PropertyKey, *onpremise.Response, error) {
+	if mock.GetKeysFunc == nil {
+		panic("mocks: PropertyService.GetKeys is not implemented")
+	}
+	return mock.GetKeysFunc(ctx, entity, entityID)
+}
+
+// Set calls SetFunc.
+func (mock *PropertyService) Set(ctx context.Context, entity onpremise.PropertyEntity, entityID string, key string, value interface{}) (*onpremise.Response, error) {
+	if mock.SetFunc == nil {
+		panic("mocks: PropertyService.Set is not implemented")
+	}
+	return mock.SetFunc(ctx, entity, entityID, key, value)
+}
+
+// SetOnIssues calls SetOnIssuesFunc.
+func (mock *PropertyService) SetOnIssues(ctx context.Context, key string, value interface{}, filter *onpremise.BulkPropertyFilter) (*onpremise.Response, error) {
+	if mock.SetOnIssuesFunc == nil {
+		panic("mocks: PropertyService.SetOnIssues is not implemented")
+	}
+	return mock.SetOnIssuesFunc(ctx, key, value, filter)
+}
+
+// RequestService is a mock of onpremise.RequestAPI.
+type RequestService struct {
+	CreateFunc        func(context.Context, string, []string, *onpremise.Request) (*onpremise.Request, *onpremise.Response, error)
+	CreateCommentFunc func(context.Context, string, *onpremise.RequestComment) (*onpremise.RequestComment, *onpremise.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *RequestService) Create(ctx context.Context, requester string, participants []string, request *onpremise.Request) (*onpremise.Request, *onpremise.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: RequestService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, requester, participants, request)
+}
+
+// CreateComment calls CreateCommentFunc.
+func (mock *RequestService) CreateComment(ctx context.Context, issueIDOrKey string, comment *onpremise.RequestComment) (*onpremise.RequestComment, *onpremise.Response, error) {
+	if mock.CreateCommentFunc == nil {
+		panic("mocks: RequestService.CreateComment is not implemented")
+	}
+	return mock.CreateCommentFunc(ctx, issueIDOrKey, comment)
+}
+
+// ResolutionService is a mock of onpremise.ResolutionAPI.
+type ResolutionService struct {
+	GetListFunc func(context.Context) ([]onpremise.Resolution, *onpremise.Response, error)
+}
+
+// GetList calls GetListFunc.
+func (mock *ResolutionService) GetList(ctx context.Context) ([]onpremise.Resolution, *onpremise.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: ResolutionService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// RoleService is a mock of onpremise.RoleAPI.
+type RoleService struct {
+	GetFunc     func(context.Context, int) (*onpremise.Role, *onpremise.Response, error)
+	GetListFunc func(context.Context) (*[]onpremise.Role, *onpremise.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *RoleService) Get(ctx context.Context, roleID int) (*onpremise.Role, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: RoleService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, roleID)
+}
+
+// GetList calls GetListFunc.
+func (mock *RoleService) GetList(ctx context.Context) (*[]onpremise.Role, *onpremise.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: RoleService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// ServerInfoService is a mock of onpremise.ServerInfoAPI.
+type ServerInfoService struct {
+	GetFunc func(context.Context) (*onpremise.ServerInfo, *onpremise.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *ServerInfoService) Get(ctx context.Context) (*onpremise.ServerInfo, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: ServerInfoService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx)
+}
+
+// ServiceDeskService is a mock of onpremise.ServiceDeskAPI.
+type ServiceDeskService struct {
+	AddCustomersFunc       func(context.Context, interface{}, ...string) (*onpremise.Response, error)
+	AddOrganizationFunc    func(context.Context, interface{}, int) (*onpremise.Response, error)
+	GetOrganizationsFunc   func(context.Context, interface{}, int, int, string) (*onpremise.PagedDTO, *onpremise.Response, error)
+	ListCustomersFunc      func(context.Context, interface{}, *onpremise.CustomerListOptions) (*onpremise.CustomerList, *onpremise.Response, error)
+	RemoveCustomersFunc    func(context.Context, interface{}, ...string) (*onpremise.Response, error)
+	RemoveOrganizationFunc func(context.Context, interface{}, int) (*onpremise.Response, error)
+}
+
+// AddCustomers calls AddCustomersFunc.
+func (mock *ServiceDeskService) AddCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*onpremise.Response, error) {
+	if mock.AddCustomersFunc == nil {
+		panic("mocks: ServiceDeskService.AddCustomers is not implemented")
+	}
+	return mock.AddCustomersFunc(ctx, serviceDeskID, acountIDs...)
+}
+
+// AddOrganization calls AddOrganizationFunc.
+func (mock *ServiceDeskService) AddOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*onpremise.Response, error) {
+	if mock.AddOrganizationFunc == nil {
+		panic("mocks: ServiceDeskService.AddOrganization is not implemented")
+	}
+	return mock.AddOrganizationFunc(ctx, serviceDeskID, organizationID)
+}
+
+// GetOrganizations calls GetOrganizationsFunc.
+func (mock *ServiceDeskService) GetOrganizations(ctx context.Context, serviceDeskID interface{}, start int, limit int, accountID string) (*onpremise.PagedDTO, *onpremise.Response, error) {
+	if mock.GetOrganizationsFunc == nil {
+		panic("mocks: ServiceDeskService.GetOrganizations is not implemented")
+	}
+	return mock.GetOrganizationsFunc(ctx, serviceDeskID, start, limit, accountID)
+}
+
+// ListCustomers calls ListCustomersFunc.
+func (mock *ServiceDeskService) ListCustomers(ctx context.Context, serviceDeskID interface{}, options *onpremise.CustomerListOptions) (*onpremise.CustomerList, *onpremise.Response, error) {
+	if mock.ListCustomersFunc == nil {
+		panic("mocks: ServiceDeskService.ListCustomers is not implemented")
+	}
+	return mock.ListCustomersFunc(ctx, serviceDeskID, options)
+}
+
+// RemoveCustomers calls RemoveCustomersFunc.
+func (mock *ServiceDeskService) RemoveCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*onpremise.Response, error) {
+	if mock.RemoveCustomersFunc == nil {
+		panic("mocks: ServiceDeskService.RemoveCustomers is not implemented")
+	}
+	return mock.RemoveCustomersFunc(ctx, serviceDeskID, acountIDs...)
+}
+
+// RemoveOrganization calls RemoveOrganizationFunc.
+func (mock *ServiceDeskService) RemoveOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*onpremise.Response, error) {
+	if mock.RemoveOrganizationFunc == nil {
+		panic("mocks: ServiceDeskService.RemoveOrganization is not implemented")
+	}
+	return mock.RemoveOrganizationFunc(ctx, serviceDeskID, organizationID)
+}
+
+// SprintService is a mock of onpremise.SprintAPI.
+type SprintService struct {
+	GetIssueFunc           func(context.Context, string, *onpremise.GetQueryOptions) (*onpremise.Issue, *onpremise.Response, error)
+	GetIssuesForSprintFunc func(context.Context, int) ([]onpremise.Issue, *onpremise.Response, error)
+	MoveIssuesToSprintFunc func(context.Context, int, []string) (*onpremise.Response, error)
+}
+
+// GetIssue calls GetIssueFunc.
+func (mock *SprintService) GetIssue(ctx context.Context, issueID string, options *onpremise.GetQueryOptions) (*onpremise.Issue, *onpremise.Response, error) {
+	if mock.GetIssueFunc == nil {
+		panic("mocks: SprintService.GetIssue is not implemented")
+	}
+	return mock.GetIssueFunc(ctx, issueID, options)
+}
+
+// GetIssuesForSprint calls GetIssuesForSprintFunc.
+func (mock *SprintService) GetIssuesForSprint(ctx context.Context, sprintID int) ([]onpremise.Issue, *onpremise.Response, error) {
+	if mock.GetIssuesForSprintFunc == nil {
+		panic("mocks: SprintService.GetIssuesForSprint is not implemented")
+	}
+	return mock.GetIssuesForSprintFunc(ctx, sprintID)
+}
+
+// MoveIssuesToSprint calls MoveIssuesToSprintFunc.
+func (mock *SprintService) MoveIssuesToSprint(ctx context.Context, sprintID int, issueIDs []string) (*onpremise.Response, error) {
+	if mock.MoveIssuesToSprintFunc == nil {
+		panic("mocks: SprintService.MoveIssuesToSprint is not implemented")
+	}
+	return mock.MoveIssuesToSprintFunc(ctx, sprintID, issueIDs)
+}
+
+// StatusCategoryService is a mock of onpremise.StatusCategoryAPI.
+type StatusCategoryService struct {
+	GetFunc     func(context.Context, string) (*onpremise.StatusCategory, *onpremise.Response, error)
+	GetListFunc func(context.Context) ([]onpremise.StatusCategory, *onpremise.Response, error)
+}
+
+// Get calls GetFunc.
+func (mock *StatusCategoryService) Get(ctx context.Context, statusCategoryID string) (*onpremise.StatusCategory, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: StatusCategoryService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, statusCategoryID)
+}
+
+// GetList calls GetListFunc.
+func (mock *StatusCategoryService) GetList(ctx context.Context) ([]onpremise.StatusCategory, *onpremise.Response, error) {
+	if mock.GetListFunc == nil {
+		panic("mocks: StatusCategoryService.GetList is not implemented")
+	}
+	return mock.GetListFunc(ctx)
+}
+
+// StatusService is a mock of onpremise.StatusAPI.
+type StatusService struct {
+	GetAllStatusesFunc       func(context.Context) ([]onpremise.Status, *onpremise.Response, error)
+	GetStatusesPaginatedFunc func(context.Context, *onpremise.StatusSearchOptions) ([]onpremise.Status, *onpremise.Response, error)
+}
+
+// GetAllStatuses calls GetAllStatusesFunc.
+func (mock *StatusService) GetAllStatuses(ctx context.Context) ([]onpremise.Status, *onpremise.Response, error) {
+	if mock.GetAllStatusesFunc == nil {
+		panic("mocks: StatusService.GetAllStatuses is not implemented")
+	}
+	return mock.GetAllStatusesFunc(ctx)
+}
+
+// GetStatusesPaginated calls GetStatusesPaginatedFunc.
+func (mock *StatusService) GetStatusesPaginated(ctx context.Context, options *onpremise.StatusSearchOptions) ([]onpremise.Status, *onpremise.Response, error) {
+	if mock.GetStatusesPaginatedFunc == nil {
+		panic("mocks: StatusService.GetStatusesPaginated is not implemented")
+	}
+	return mock.GetStatusesPaginatedFunc(ctx, options)
+}
+
+// UserService is a mock of onpremise.UserAPI.
+type UserService struct {
+	CreateFunc         func(context.Context, *onpremise.User) (*onpremise.User, *onpremise.Response, error)
+	DeleteFunc         func(context.Context, string) (*onpremise.Response, error)
+	FindFunc           func(context.Context, string, ...onpremise.UserSearchF) ([]onpremise.User, *onpremise.Response, error)
+	GetFunc            func(context.Context, string) (*onpremise.User, *onpremise.Response, error)
+	GetByAccountIDFunc func(context.Context, string) (*onpremise.User, *onpremise.Response, error)
+	GetGroupsFunc      func(context.Context, string) (*[]onpremise.UserGroup, *onpremise.Response, error)
+	GetSelfFunc        func(context.Context) (*onpremise.User, *onpremise.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *UserService) Create(ctx context.Context, user *onpremise.User) (*onpremise.User, *onpremise.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: UserService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, user)
+}
+
+// Delete calls DeleteFunc.
+func (mock *UserService) Delete(ctx context.Context, accountId string) (*onpremise.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("mocks: UserService.Delete is not implemented")
+	}
+	return mock.DeleteFunc(ctx, accountId)
+}
+
+// Find calls FindFunc.
+func (mock *UserService) Find(ctx context.Context, property string, tweaks ...onpremise.UserSearchF) ([]onpremise.User, *onpremise.Response, error) {
+	if mock.FindFunc == nil {
+		panic("mocks: UserService.Find is not implemented")
+	}
+	return mock.FindFunc(ctx, property, tweaks...)
+}
+
+// Get calls GetFunc.
+func (mock *UserService) Get(ctx context.Context, accountId string) (*onpremise.User, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: UserService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, accountId)
+}
+
+// GetByAccountID calls GetByAccountIDFunc.
+func (mock *UserService) GetByAccountID(ctx context.Context, accountID string) (*onpremise.User, *onpremise.Response, error) {
+	if mock.GetByAccountIDFunc == nil {
+		panic("mocks: UserService.GetByAccountID is not implemented")
+	}
+	return mock.GetByAccountIDFunc(ctx, accountID)
+}
+
+// GetGroups calls GetGroupsFunc.
+func (mock *UserService) GetGroups(ctx context.Context, accountId string) (*[]onpremise.UserGroup, *onpremise.Response, error) {
+	if mock.GetGroupsFunc == nil {
+		panic("mocks: UserService.GetGroups is not implemented")
+	}
+	return mock.GetGroupsFunc(ctx, accountId)
+}
+
+// GetSelf calls GetSelfFunc.
+func (mock *UserService) GetSelf(ctx context.Context) (*onpremise.User, *onpremise.Response, error) {
+	if mock.GetSelfFunc == nil {
+		panic("mocks: UserService.GetSelf is not implemented")
+	}
+	return mock.GetSelfFunc(ctx)
+}
+
+// VersionService is a mock of onpremise.VersionAPI.
+type VersionService struct {
+	CreateFunc func(context.Context, *onpremise.Version) (*onpremise.Version, *onpremise.Response, error)
+	GetFunc    func(context.Context, int) (*onpremise.Version, *onpremise.Response, error)
+	UpdateFunc func(context.Context, *onpremise.Version) (*onpremise.Version, *onpremise.Response, error)
+}
+
+// Create calls CreateFunc.
+func (mock *VersionService) Create(ctx context.Context, version *onpremise.Version) (*onpremise.Version, *onpremise.Response, error) {
+	if mock.CreateFunc == nil {
+		panic("mocks: VersionService.Create is not implemented")
+	}
+	return mock.CreateFunc(ctx, version)
+}
+
+// Get calls GetFunc.
+func (mock *VersionService) Get(ctx context.Context, versionID int) (*onpremise.Version, *onpremise.Response, error) {
+	if mock.GetFunc == nil {
+		panic("mocks: VersionService.Get is not implemented")
+	}
+	return mock.GetFunc(ctx, versionID)
+}
+
+// Update calls UpdateFunc.
+func (mock *VersionService) Update(ctx context.Context, version *onpremise.Version) (*onpremise.Version, *onpremise.Response, error) {
+	if mock.UpdateFunc == nil {
+		panic("mocks: VersionService.Update is not implemented")
+	}
+	return mock.UpdateFunc(ctx, version)
+}
+
+// Compile-time checks that the mocks implement the interfaces.
+var (
+	_ onpremise.ApplicationRoleAPI  = (*ApplicationRoleService)(nil)
+	_ onpremise.AuthenticationAPI   = (*AuthenticationService)(nil)
+	_ onpremise.BoardAPI            = (*BoardService)(nil)
+	_ onpremise.ComponentAPI        = (*ComponentService)(nil)
+	_ onpremise.CustomerAPI         = (*CustomerService)(nil)
+	_ onpremise.FieldAPI            = (*FieldService)(nil)
+	_ onpremise.FilterAPI           = (*FilterService)(nil)
+	_ onpremise.GroupAPI            = (*GroupService)(nil)
+	_ onpremise.IssueLinkTypeAPI    = (*IssueLinkTypeService)(nil)
+	_ onpremise.IssueAPI            = (*IssueService)(nil)
+	_ onpremise.OrganizationAPI     = (*OrganizationService)(nil)
+	_ onpremise.PATAPI              = (*PATService)(nil)
+	_ onpremise.PermissionSchemeAPI = (*PermissionSchemeService)(nil)
+	_ onpremise.PriorityAPI         = (*PriorityService)(nil)
+	_ onpremise.ProjectCategoryAPI  = (*ProjectCategoryService)(nil)
+	_ onpremise.ProjectAPI          = (*ProjectService)(nil)
+	_ onpremise.PropertyAPI         = (*PropertyService)(nil)
+	_ onpremise.RequestAPI          = (*RequestService)(nil)
+	_ onpremise.ResolutionAPI       = (*ResolutionService)(nil)
+	_ onpremise.RoleAPI             = (*RoleService)(nil)
+	_ onpremise.ServerInfoAPI       = (*ServerInfoService)(nil)
+	_ onpremise.ServiceDeskAPI      = (*ServiceDeskService)(nil)
+	_ onpremise.SprintAPI           = (*SprintService)(nil)
+	_ onpremise.StatusCategoryAPI   = (*StatusCategoryService)(nil)
+	_ onpremise.StatusAPI           = (*StatusService)(nil)
+	_ onpremise.UserAPI             = (*UserService)(nil)
+	_ onpremise.VersionAPI          = (*VersionService)(nil)
+)
@@ -236,21 +236,21 @@ func (s *OrganizationService) GetProperty(ctx context.Context, organizationID in
 // SetProperty sets the value of a
 // property for an organization. Use this
 // resource to store custom data against an organization.
+// The value is stored as JSON.
 //
 // https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-organization/#api-rest-servicedeskapi-organization-organizationid-property-propertykey-put
 // Caller must close resp.Body
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *OrganizationService) SetProperty(ctx context.Context, organizationID int, propertyKey string) (*Response, error) {
+func (s *OrganizationService) SetProperty(ctx context.Context, organizationID int, propertyKey string, value interface{}) (*Response, error) {
 	apiEndPoint := fmt.Sprintf("rest/servicedeskapi/organization/%d/property/%s", organizationID, propertyKey)
 
-	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndPoint, nil)
-	req.Header.Set("Accept", "application/json")
-
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndPoint, value)
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Accept", "application/json")
 
 	resp, err := s.client.Do(req, nil)
 	if err != nil {
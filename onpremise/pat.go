@@ -0,0 +1,116 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PATService handles personal access tokens for the Jira instance / API.
+// Personal access tokens are available since Jira 8.14.
+//
+// Jira docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+type PATService service
+
+// PersonalAccessToken represents a personal access token of a user.
+type PersonalAccessToken struct {
+	ID             int    `json:"id,omitempty" structs:"id,omitempty"`
+	Name           string `json:"name,omitempty" structs:"name,omitempty"`
+	CreatedAt      *Time  `json:"createdAt,omitempty" structs:"createdAt,omitempty"`
+	ExpiringAt     *Time  `json:"expiringAt,omitempty" structs:"expiringAt,omitempty"`
+	LastAccessedAt *Time  `json:"lastAccessedAt,omitempty" structs:"lastAccessedAt,omitempty"`
+
+	// RawToken is the secret token. It is only returned by PATService.Create.
+	RawToken string `json:"rawToken,omitempty" structs:"rawToken,omitempty"`
+}
+
+// NeverExpires reports whether the token has no expiry date.
+func (t *PersonalAccessToken) NeverExpires() bool {
+	return t.ExpiringAt == nil
+}
+
+// CreatePATOptions are passed to the PATService.Create function to create a new personal access token.
+type CreatePATOptions struct {
+	Name string `json:"name" structs:"name"`
+
+	// ExpirationDuration is the number of days the token is valid.
+	// If zero, the token never expires, unless the instance enforces a maximum lifetime.
+	ExpirationDuration int `json:"expirationDuration,omitempty" structs:"expirationDuration,omitempty"`
+}
+
+// GetList returns the personal access tokens of the current user.
+//
+// Jira API docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+func (s *PATService) GetList(ctx context.Context) ([]PersonalAccessToken, *Response, error) {
+	return s.getList(ctx, "rest/pat/latest/tokens")
+}
+
+// GetListForUser returns the personal access tokens of the user with the given key.
+// The current user needs the Jira administrators permission.
+//
+// Jira API docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+func (s *PATService) GetListForUser(ctx context.Context, userKey string) ([]PersonalAccessToken, *Response, error) {
+	return s.getList(ctx, "rest/pat/latest/tokens?userKey="+url.QueryEscape(userKey))
+}
+
+func (s *PATService) getList(ctx context.Context, apiEndpoint string) ([]PersonalAccessToken, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tokens []PersonalAccessToken
+	resp, err := s.client.Do(req, &tokens)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return tokens, resp, nil
+}
+
+// Create creates a personal access token for the current user.
+// The secret is returned in the RawToken field and cannot be retrieved later.
+//
+// Jira API docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+func (s *PATService) Create(ctx context.Context, options *CreatePATOptions) (*PersonalAccessToken, *Response, error) {
+	apiEndpoint := "rest/pat/latest/tokens"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, token)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return token, resp, nil
+}
+
+// Revoke revokes the personal access token of the current user with the given ID.
+//
+// Jira API docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+func (s *PATService) Revoke(ctx context.Context, tokenID int) (*Response, error) {
+	return s.revoke(ctx, fmt.Sprintf("rest/pat/latest/tokens/%d", tokenID))
+}
+
+// RevokeForUser revokes the personal access token with the given ID of the user with the given key.
+// The current user needs the Jira administrators permission.
+//
+// Jira API docs: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
+func (s *PATService) RevokeForUser(ctx context.Context, userKey string, tokenID int) (*Response, error) {
+	return s.revoke(ctx, fmt.Sprintf("rest/pat/latest/tokens/%d?userKey=%s", tokenID, url.QueryEscape(userKey)))
+}
+
+func (s *PATService) revoke(ctx context.Context, apiEndpoint string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,117 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPATService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/pat/latest/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/pat/latest/tokens")
+		fmt.Fprint(w, `[{"id":1,"name":"ci","createdAt":"2022-01-10T10:00:00.000+0000","expiringAt":"2022-04-10T10:00:00.000+0000","lastAccessedAt":"2022-02-01T08:30:00.000+0000"},{"id":2,"name":"forever","createdAt":"2021-05-01T10:00:00.000+0000"}]`)
+	})
+
+	tokens, _, err := testClient.PAT.GetList(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(tokens) != 2 {
+		t.Fatalf("Expected 2 tokens. Got %d", len(tokens))
+	}
+	if tokens[0].Name != "ci" || tokens[0].NeverExpires() || tokens[0].LastAccessedAt == nil {
+		t.Errorf("Unexpected token: %+v", tokens[0])
+	}
+	if !tokens[1].NeverExpires() {
+		t.Errorf("Expected token %d to never expire", tokens[1].ID)
+	}
+}
+
+func TestPATService_GetListForUser(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/pat/latest/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/pat/latest/tokens?userKey=JIRAUSER10100")
+		fmt.Fprint(w, `[{"id":3,"name":"backup"}]`)
+	})
+
+	tokens, _, err := testClient.PAT.GetListForUser(context.Background(), "JIRAUSER10100")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(tokens) != 1 || tokens[0].ID != 3 {
+		t.Errorf("Unexpected tokens: %+v", tokens)
+	}
+}
+
+func TestPATService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/pat/latest/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var options CreatePATOptions
+		json.NewDecoder(r.Body).Decode(&options)
+		if options.Name != "ci" || options.ExpirationDuration != 90 {
+			t.Errorf("Unexpected options: %+v", options)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":4,"name":"ci","createdAt":"2022-01-10T10:00:00.000+0000","expiringAt":"2022-04-10T10:00:00.000+0000","rawToken":"NjI4MTYxNjM5MTUzOpZkqT"}`)
+	})
+
+	token, _, err := testClient.PAT.Create(context.Background(), &CreatePATOptions{Name: "ci", ExpirationDuration: 90})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if token.ID != 4 || token.RawToken != "NjI4MTYxNjM5MTUzOpZkqT" {
+		t.Errorf("Unexpected token: %+v", token)
+	}
+}
+
+func TestPATService_Revoke(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/pat/latest/tokens/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/pat/latest/tokens/4")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.PAT.Revoke(context.Background(), 4); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPATService_RevokeForUser(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/pat/latest/tokens/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/pat/latest/tokens/3?userKey=JIRAUSER10100")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.PAT.RevokeForUser(context.Background(), "JIRAUSER10100", 3); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPATService_Revoke_NotFound(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/pat/latest/tokens/5", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages":["Token not found"],"errors":{}}`)
+	})
+
+	if _, err := testClient.PAT.Revoke(context.Background(), 5); err == nil {
+		t.Error("Expected an error")
+	}
+}
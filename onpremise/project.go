@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/google/go-querystring/query"
 )
@@ -161,3 +162,145 @@ func (s *ProjectService) GetPermissionScheme(ctx context.Context, projectID stri
 
 	return ps, resp, nil
 }
+
+// ProjectDetails are the fields of a project to create or update.
+// Lead is the user name of the project lead.
+// Zero values are not sent, so an update only changes the fields which are set.
+type ProjectDetails struct {
+	Key          string `json:"key,omitempty" structs:"key,omitempty"`
+	Name         string `json:"name,omitempty" structs:"name,omitempty"`
+	Description  string `json:"description,omitempty" structs:"description,omitempty"`
+	Lead         string `json:"lead,omitempty" structs:"lead,omitempty"`
+	URL          string `json:"url,omitempty" structs:"url,omitempty"`
+	AssigneeType string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+	AvatarID     int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	CategoryID   int    `json:"categoryId,omitempty" structs:"categoryId,omitempty"`
+
+	// PermissionScheme, NotificationScheme and IssueSecurityScheme are the IDs of the schemes of the project.
+	PermissionScheme    int `json:"permissionScheme,omitempty" structs:"permissionScheme,omitempty"`
+	NotificationScheme  int `json:"notificationScheme,omitempty" structs:"notificationScheme,omitempty"`
+	IssueSecurityScheme int `json:"issueSecurityScheme,omitempty" structs:"issueSecurityScheme,omitempty"`
+
+	// ProjectTypeKey, like "software", and ProjectTemplateKey,
+	// like "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic", are only used on create.
+	ProjectTypeKey     string `json:"projectTypeKey,omitempty" structs:"projectTypeKey,omitempty"`
+	ProjectTemplateKey string `json:"projectTemplateKey,omitempty" structs:"projectTemplateKey,omitempty"`
+}
+
+// ProjectIdentifiers identify a created project.
+type ProjectIdentifiers struct {
+	Self string `json:"self" structs:"self"`
+	ID   int    `json:"id" structs:"id"`
+	Key  string `json:"key" structs:"key"`
+}
+
+// Create creates a project from a template with the given lead and schemes.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-createProject
+func (s *ProjectService) Create(ctx context.Context, project *ProjectDetails) (*ProjectIdentifiers, *Response, error) {
+	apiEndpoint := "rest/api/2/project"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(ProjectIdentifiers)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return created, resp, nil
+}
+
+// Update changes the fields of the project which are set in project, like the name, lead or category.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-updateProject
+func (s *ProjectService) Update(ctx context.Context, projectIDOrKey string, project *ProjectDetails) (*Project, *Response, error) {
+	apiEndpoint := "rest/api/2/project/" + url.PathEscape(projectIDOrKey)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(Project)
+	resp, err := s.client.Do(req, updated)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return updated, resp, nil
+}
+
+// Archive archives the project. Archived projects are read-only and can be restored with Restore.
+//
+// Archiving projects requires Jira Data Center 7.10 or later.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-archiveProject
+func (s *ProjectService) Archive(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	apiEndpoint := "rest/api/2/project/" + url.PathEscape(projectIDOrKey) + "/archive"
+	return s.send(ctx, http.MethodPut, apiEndpoint, nil)
+}
+
+// Restore restores an archived project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-restoreProject
+func (s *ProjectService) Restore(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	apiEndpoint := "rest/api/2/project/" + url.PathEscape(projectIDOrKey) + "/restore"
+	return s.send(ctx, http.MethodPut, apiEndpoint, nil)
+}
+
+// Delete deletes the project with all its issues permanently.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-deleteProject
+func (s *ProjectService) Delete(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	apiEndpoint := "rest/api/2/project/" + url.PathEscape(projectIDOrKey)
+	return s.send(ctx, http.MethodDelete, apiEndpoint, nil)
+}
+
+// AssignPermissionScheme assigns the permission scheme with the given ID to the project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectKeyOrId}/permissionscheme-assignPermissionScheme
+func (s *ProjectService) AssignPermissionScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*PermissionScheme, *Response, error) {
+	apiEndpoint := "rest/api/2/project/" + url.PathEscape(projectIDOrKey) + "/permissionscheme"
+	body := struct {
+		ID int `json:"id"`
+	}{schemeID}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps := new(PermissionScheme)
+	resp, err := s.client.Do(req, ps)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return ps, resp, nil
+}
+
+// AssignNotificationScheme assigns the notification scheme with the given ID to the project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-updateProject
+func (s *ProjectService) AssignNotificationScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*Project, *Response, error) {
+	return s.Update(ctx, projectIDOrKey, &ProjectDetails{NotificationScheme: schemeID})
+}
+
+// AssignIssueSecurityScheme assigns the issue security scheme with the given ID to the project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-updateProject
+func (s *ProjectService) AssignIssueSecurityScheme(ctx context.Context, projectIDOrKey string, schemeID int) (*Project, *Response, error) {
+	return s.Update(ctx, projectIDOrKey, &ProjectDetails{IssueSecurityScheme: schemeID})
+}
+
+// send sends a request without a response body.
+func (s *ProjectService) send(ctx context.Context, method, apiEndpoint string, body interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
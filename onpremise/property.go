@@ -0,0 +1,203 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PropertyService handles the entity properties of issues, projects, users, comments and issue types
+// for the Jira instance / API.
+// Entity properties store custom JSON data, like external IDs or sync state, on Jira entities.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/jira-entity-properties/
+type PropertyService service
+
+// PropertyEntity is a kind of Jira entity which has properties.
+type PropertyEntity string
+
+// Entities with properties.
+// The entity ID is the ID or key of issues and projects, the ID of comments and issue types,
+// and the user name of users.
+const (
+	PropertyEntityIssue     PropertyEntity = "issue"
+	PropertyEntityProject   PropertyEntity = "project"
+	PropertyEntityUser      PropertyEntity = "user"
+	PropertyEntityComment   PropertyEntity = "comment"
+	PropertyEntityIssueType PropertyEntity = "issuetype"
+)
+
+// Property is an entity property with its raw JSON value.
+type Property struct {
+	Key   string          `json:"key" structs:"key"`
+	Value json.RawMessage `json:"value" structs:"value"`
+}
+
+// Decode decodes the JSON value of the property into v.
+func (p *Property) Decode(v interface{}) error {
+	return json.Unmarshal(p.Value, v)
+}
+
+// PropertyValue returns the value of the property decoded as T.
+func PropertyValue[T any](p *Property) (T, error) {
+	var v T
+	err := p.Decode(&v)
+	return v, err
+}
+
+// GetPropertyValue returns the value of the property with the given key decoded as T.
+func GetPropertyValue[T any](ctx context.Context, s PropertyAPI, entity PropertyEntity, entityID, key string) (T, *Response, error) {
+	var v T
+	p, resp, err := s.Get(ctx, entity, entityID, key)
+	if err != nil {
+		return v, resp, err
+	}
+	v, err = PropertyValue[T](p)
+	return v, resp, err
+}
+
+// BulkPropertyFilter selects the issues of a bulk property update.
+// Without any condition, the property is set on all issues.
+type BulkPropertyFilter struct {
+	// EntityIDs are the IDs of the issues to update.
+	EntityIDs []int `json:"entityIds,omitempty" structs:"entityIds,omitempty"`
+
+	// CurrentValue only updates issues whose property has this value.
+	CurrentValue interface{} `json:"currentValue,omitempty" structs:"currentValue,omitempty"`
+
+	// HasProperty only updates issues which have (true) or don't have (false) the property.
+	HasProperty *bool `json:"hasProperty,omitempty" structs:"hasProperty,omitempty"`
+}
+
+// propertiesPath returns the path of the properties of an entity, or of a single property if key is set.
+func propertiesPath(entity PropertyEntity, entityID, key string) (string, error) {
+	if entityID == "" {
+		return "", errors.New("entity ID must not be empty")
+	}
+	var path string
+	switch entity {
+	case PropertyEntityUser:
+		// Users are addressed by a query parameter
+		path = "rest/api/2/user/properties"
+	case PropertyEntityIssue, PropertyEntityProject, PropertyEntityComment, PropertyEntityIssueType:
+		path = fmt.Sprintf("rest/api/2/%s/%s/properties", entity, url.PathEscape(entityID))
+	default:
+		return "", fmt.Errorf("entity %q has no properties", entity)
+	}
+	if key != "" {
+		path += "/" + url.PathEscape(key)
+	}
+	if entity == PropertyEntityUser {
+		path += "?username=" + url.QueryEscape(entityID)
+	}
+	return path, nil
+}
+
+// GetKeys returns the keys of all properties of an entity.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/properties-getPropertiesKeys
+func (s *PropertyService) GetKeys(ctx context.Context, entity PropertyEntity, entityID string) ([]PropertyKey, *Response, error) {
+	apiEndpoint, err := propertiesPath(entity, entityID, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(PropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return keys.Keys, resp, nil
+}
+
+// Get returns the property of an entity with the given key.
+// Use Property.Decode or PropertyValue to decode its value.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/properties-getProperty
+func (s *PropertyService) Get(ctx context.Context, entity PropertyEntity, entityID, key string) (*Property, *Response, error) {
+	apiEndpoint, err := propertiesPath(entity, entityID, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(Property)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return property, resp, nil
+}
+
+// Set stores value as JSON in the property of an entity with the given key.
+// The property is created if it doesn't exist.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/properties-setProperty
+func (s *PropertyService) Set(ctx context.Context, entity PropertyEntity, entityID, key string, value interface{}) (*Response, error) {
+	apiEndpoint, err := propertiesPath(entity, entityID, key)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete removes the property of an entity with the given key.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/properties-deleteProperty
+func (s *PropertyService) Delete(ctx context.Context, entity PropertyEntity, entityID, key string) (*Response, error) {
+	apiEndpoint, err := propertiesPath(entity, entityID, key)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// SetOnIssues stores value as JSON in the property with the given key of all issues matching the filter.
+// Jira processes the update asynchronously as a long-running task.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/properties-bulkSetIssueProperty
+func (s *PropertyService) SetOnIssues(ctx context.Context, key string, value interface{}, filter *BulkPropertyFilter) (*Response, error) {
+	apiEndpoint := "rest/api/2/issue/properties/" + url.PathEscape(key)
+	body := struct {
+		Value  interface{}         `json:"value"`
+		Filter *BulkPropertyFilter `json:"filter,omitempty"`
+	}{value, filter}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,126 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type syncState struct {
+	ExternalID string `json:"externalId"`
+	Revision   int    `json:"revision"`
+}
+
+func TestPropertyService_GetKeys(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/properties", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/properties")
+		fmt.Fprint(w, `{"keys":[{"self":"https://jira.example.com/rest/api/2/issue/EX-1/properties/sync","key":"sync"}]}`)
+	})
+
+	keys, _, err := testClient.Property.GetKeys(context.Background(), PropertyEntityIssue, "EX-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(keys) != 1 || keys[0].Key != "sync" {
+		t.Errorf("Expected key sync, got %v", keys)
+	}
+}
+
+func TestPropertyService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX/properties/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/project/EX/properties/sync")
+		fmt.Fprint(w, `{"key":"sync","value":{"externalId":"ext-42","revision":3}}`)
+	})
+
+	property, _, err := testClient.Property.Get(context.Background(), PropertyEntityProject, "EX", "sync")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	state, err := PropertyValue[syncState](property)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if state.ExternalID != "ext-42" || state.Revision != 3 {
+		t.Errorf("Expected ext-42 at revision 3, got %+v", state)
+	}
+
+	state, _, err = GetPropertyValue[syncState](context.Background(), testClient.Property, PropertyEntityProject, "EX", "sync")
+	if err != nil || state.ExternalID != "ext-42" {
+		t.Errorf("GetPropertyValue() = %+v, %v", state, err)
+	}
+}
+
+func TestPropertyService_Set_User(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/properties/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, "/rest/api/2/user/properties/sync?username=dave")
+		body, _ := io.ReadAll(r.Body)
+		if got := strings.TrimSpace(string(body)); got != `{"externalId":"ext-42","revision":4}` {
+			t.Errorf("Unexpected body %s", got)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := testClient.Property.Set(context.Background(), PropertyEntityUser, "dave", "sync", syncState{ExternalID: "ext-42", Revision: 4})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPropertyService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/comment/10000/properties/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, "/rest/api/2/comment/10000/properties/sync")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Property.Delete(context.Background(), PropertyEntityComment, "10000", "sync")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPropertyService_SetOnIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/properties/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		if got := strings.TrimSpace(string(body)); got != `{"value":{"externalId":"","revision":0},"filter":{"entityIds":[10001,10002],"hasProperty":false}}` {
+			t.Errorf("Unexpected body %s", got)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	hasProperty := false
+	filter := &BulkPropertyFilter{EntityIDs: []int{10001, 10002}, HasProperty: &hasProperty}
+	_, err := testClient.Property.SetOnIssues(context.Background(), "sync", syncState{}, filter)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPropertyService_InvalidEntity(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if _, _, err := testClient.Property.Get(context.Background(), PropertyEntity("board"), "1", "sync"); err == nil {
+		t.Error("Expected error for entity without properties")
+	}
+	if _, err := testClient.Property.Delete(context.Background(), PropertyEntityIssueType, "", "sync"); err == nil {
+		t.Error("Expected error for empty entity ID")
+	}
+}
@@ -0,0 +1,163 @@
+// Package webhook receives Jira On-Premise (Server / Data Center) webhooks.
+//
+// It parses webhook payloads into typed events that reuse the types of the onpremise package,
+// verifies the HMAC signature of a webhook and dispatches the events to registered handler functions.
+//
+// Jira docs: https://developer.atlassian.com/server/jira/platform/webhooks/
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	jira "github.com/conductorone/go-jira/v2/onpremise"
+)
+
+// Webhook event types sent by Jira On-Premise.
+const (
+	IssueCreated = "jira:issue_created"
+	IssueUpdated = "jira:issue_updated"
+	IssueDeleted = "jira:issue_deleted"
+
+	CommentCreated = "comment_created"
+	CommentUpdated = "comment_updated"
+	CommentDeleted = "comment_deleted"
+
+	WorklogCreated = "worklog_created"
+	WorklogUpdated = "worklog_updated"
+	WorklogDeleted = "worklog_deleted"
+
+	SprintCreated = "sprint_created"
+	SprintUpdated = "sprint_updated"
+	SprintStarted = "sprint_started"
+	SprintClosed  = "sprint_closed"
+	SprintDeleted = "sprint_deleted"
+
+	UserCreated = "user_created"
+	UserUpdated = "user_updated"
+	UserDeleted = "user_deleted"
+
+	GroupCreated = "group_created"
+	GroupDeleted = "group_deleted"
+)
+
+// Event is a parsed webhook payload.
+// It is one of *IssueEvent, *CommentEvent, *WorklogEvent, *SprintEvent,
+// *UserEvent, *GroupEvent or *UnknownEvent.
+type Event interface {
+	// Type returns the webhook event type, like "jira:issue_created".
+	Type() string
+
+	// Time returns the time the event was created by Jira.
+	Time() time.Time
+}
+
+// Envelope holds the fields every webhook payload contains.
+type Envelope struct {
+	Timestamp    int64  `json:"timestamp"`
+	WebhookEvent string `json:"webhookEvent"`
+}
+
+// Type returns the webhook event type.
+func (e *Envelope) Type() string {
+	return e.WebhookEvent
+}
+
+// Time returns the time the event was created by Jira.
+func (e *Envelope) Time() time.Time {
+	return time.UnixMilli(e.Timestamp)
+}
+
+// IssueEvent is sent for jira:issue_created, jira:issue_updated and jira:issue_deleted.
+type IssueEvent struct {
+	Envelope
+	IssueEventTypeName string                 `json:"issue_event_type_name,omitempty"`
+	User               *jira.User             `json:"user,omitempty"`
+	Issue              *jira.Issue            `json:"issue,omitempty"`
+	Changelog          *jira.ChangelogHistory `json:"changelog,omitempty"`
+	// Comment is set if a comment was added together with the issue update.
+	Comment *jira.Comment `json:"comment,omitempty"`
+}
+
+// CommentEvent is sent for comment_created, comment_updated and comment_deleted.
+type CommentEvent struct {
+	Envelope
+	Comment *jira.Comment `json:"comment,omitempty"`
+	// Issue contains only a subset of the issue fields.
+	Issue *jira.Issue `json:"issue,omitempty"`
+}
+
+// WorklogEvent is sent for worklog_created, worklog_updated and worklog_deleted.
+type WorklogEvent struct {
+	Envelope
+	Worklog *jira.WorklogRecord `json:"worklog,omitempty"`
+}
+
+// SprintEvent is sent for sprint_created, sprint_updated, sprint_started, sprint_closed and sprint_deleted.
+type SprintEvent struct {
+	Envelope
+	Sprint *jira.Sprint `json:"sprint,omitempty"`
+	// OldValue holds the previous state of the sprint on sprint_updated.
+	OldValue *jira.Sprint `json:"oldValue,omitempty"`
+}
+
+// UserEvent is sent for user_created, user_updated and user_deleted.
+type UserEvent struct {
+	Envelope
+	User *jira.User `json:"user,omitempty"`
+}
+
+// GroupEvent is sent for group_created and group_deleted.
+type GroupEvent struct {
+	Envelope
+	Group *Group `json:"group,omitempty"`
+}
+
+// Group is the group of a GroupEvent.
+// The onpremise Group type describes the group schema and does not match the webhook payload.
+type Group struct {
+	Name string `json:"name"`
+}
+
+// UnknownEvent is returned for event types this package has no typed event for.
+type UnknownEvent struct {
+	Envelope
+	// Payload is the raw webhook payload.
+	Payload json.RawMessage `json:"-"`
+}
+
+// Parse parses a webhook payload into a typed event.
+func Parse(payload []byte) (Event, error) {
+	var envelope Envelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return nil, fmt.Errorf("webhook: could not parse payload: %w", err)
+	}
+	if envelope.WebhookEvent == "" {
+		return nil, fmt.Errorf("webhook: payload has no webhookEvent")
+	}
+
+	var event Event
+	switch eventType := envelope.WebhookEvent; {
+	case strings.HasPrefix(eventType, "jira:issue_"):
+		event = &IssueEvent{}
+	case strings.HasPrefix(eventType, "comment_"):
+		event = &CommentEvent{}
+	case strings.HasPrefix(eventType, "worklog_"):
+		event = &WorklogEvent{}
+	case strings.HasPrefix(eventType, "sprint_"):
+		event = &SprintEvent{}
+	case strings.HasPrefix(eventType, "user_"):
+		event = &UserEvent{}
+	case strings.HasPrefix(eventType, "group_"):
+		event = &GroupEvent{}
+	default:
+		return &UnknownEvent{Envelope: envelope, Payload: payload}, nil
+	}
+
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, fmt.Errorf("webhook: could not parse %s payload: %w", envelope.WebhookEvent, err)
+	}
+	return event, nil
+}
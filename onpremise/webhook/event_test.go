@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"testing"
+)
+
+func TestParse_IssueUpdated(t *testing.T) {
+	payload := `{
+		"timestamp": 1525698237764,
+		"webhookEvent": "jira:issue_updated",
+		"issue_event_type_name": "issue_generic",
+		"user": {"name": "mia", "key": "JIRAUSER10100", "displayName": "Mia Krystof"},
+		"issue": {"id": "10002", "key": "TEST-2", "fields": {"summary": "Broken build"}},
+		"changelog": {"id": "10010", "items": [{"field": "status", "fromString": "To Do", "toString": "In Progress"}]}
+	}`
+
+	event, err := Parse([]byte(payload))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	issueEvent, ok := event.(*IssueEvent)
+	if !ok {
+		t.Fatalf("Expected *IssueEvent. Got %T", event)
+	}
+	if issueEvent.Type() != IssueUpdated {
+		t.Errorf("Type: %q, want %q", issueEvent.Type(), IssueUpdated)
+	}
+	if issueEvent.Time().UnixMilli() != 1525698237764 {
+		t.Errorf("Time: %v", issueEvent.Time())
+	}
+	if issueEvent.Issue.Key != "TEST-2" || issueEvent.Issue.Fields.Summary != "Broken build" {
+		t.Errorf("Unexpected issue: %+v", issueEvent.Issue)
+	}
+	if issueEvent.User.Name != "mia" {
+		t.Errorf("Unexpected user: %+v", issueEvent.User)
+	}
+	if len(issueEvent.Changelog.Items) != 1 || issueEvent.Changelog.Items[0].ToString != "In Progress" {
+		t.Errorf("Unexpected changelog: %+v", issueEvent.Changelog)
+	}
+}
+
+func TestParse_EventTypes(t *testing.T) {
+	tests := []struct {
+		payload string
+		check   func(Event) bool
+	}{
+		{`{"webhookEvent":"comment_created","comment":{"id":"1","body":"Hi"},"issue":{"key":"TEST-1"}}`, func(e Event) bool {
+			c, ok := e.(*CommentEvent)
+			return ok && c.Comment.Body == "Hi" && c.Issue.Key == "TEST-1"
+		}},
+		{`{"webhookEvent":"worklog_updated","worklog":{"id":"2","timeSpent":"1h"}}`, func(e Event) bool {
+			w, ok := e.(*WorklogEvent)
+			return ok && w.Worklog.TimeSpent == "1h"
+		}},
+		{`{"webhookEvent":"sprint_started","sprint":{"id":3,"name":"Sprint 3","state":"active","startDate":"2024-01-01T09:00:00.000Z"}}`, func(e Event) bool {
+			s, ok := e.(*SprintEvent)
+			return ok && s.Sprint.ID == 3 && s.Sprint.StartDate != nil
+		}},
+		{`{"webhookEvent":"user_created","user":{"name":"jane","displayName":"Jane"}}`, func(e Event) bool {
+			u, ok := e.(*UserEvent)
+			return ok && u.User.Name == "jane"
+		}},
+		{`{"webhookEvent":"group_created","group":{"name":"jira-administrators"}}`, func(e Event) bool {
+			g, ok := e.(*GroupEvent)
+			return ok && g.Group.Name == "jira-administrators"
+		}},
+		{`{"webhookEvent":"board_created","board":{"id":1}}`, func(e Event) bool {
+			u, ok := e.(*UnknownEvent)
+			return ok && len(u.Payload) > 0
+		}},
+	}
+
+	for _, tt := range tests {
+		event, err := Parse([]byte(tt.payload))
+		if err != nil {
+			t.Errorf("Error given for %s: %s", tt.payload, err)
+			continue
+		}
+		if !tt.check(event) {
+			t.Errorf("Unexpected event for %s: %#v", tt.payload, event)
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, payload := range []string{`not json`, `{}`, `{"webhookEvent":"jira:issue_created","issue":"wrong"}`} {
+		if _, err := Parse([]byte(payload)); err == nil {
+			t.Errorf("Expected an error for %s. Got none", payload)
+		}
+	}
+}
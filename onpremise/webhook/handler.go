@@ -0,0 +1,223 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// DefaultMaxBodySize is the maximum size of a webhook payload accepted by a Handler,
+// if Handler.MaxBodySize is not set.
+const DefaultMaxBodySize = 10 << 20
+
+// HandlerFunc handles a single webhook event.
+//
+// Returning an error responds with 500 Internal Server Error, which makes Jira retry the delivery.
+// Wrap the error with Permanent if a retry would not help.
+type HandlerFunc func(ctx context.Context, event Event) error
+
+// Handler is an http.Handler that receives Jira webhooks,
+// verifies them and dispatches the parsed events to the registered HandlerFuncs.
+//
+// Response codes:
+//   - 204 No Content if the event was handled (or no HandlerFunc is registered for it)
+//   - 400 Bad Request if the payload could not be parsed
+//   - 401 Unauthorized if the Verifier rejected the request
+//   - 405 Method Not Allowed for other methods than POST
+//   - 413 Request Entity Too Large if the payload exceeds MaxBodySize
+//   - 422 Unprocessable Entity if the HandlerFunc returned a Permanent error
+//   - 500 Internal Server Error if the HandlerFunc returned any other error
+type Handler struct {
+	// Verifier verifies incoming requests.
+	// If nil, requests are not verified.
+	Verifier Verifier
+
+	// MaxBodySize limits the size of accepted payloads.
+	// It defaults to DefaultMaxBodySize.
+	MaxBodySize int64
+
+	// ErrorLog is called with errors of requests that could not be handled.
+	// If nil, errors are not reported.
+	ErrorLog func(r *http.Request, err error)
+
+	mu       sync.RWMutex
+	handlers map[string]HandlerFunc
+	fallback HandlerFunc
+}
+
+// NewHandler returns a new Handler verifying requests with v.
+func NewHandler(v Verifier) *Handler {
+	return &Handler{Verifier: v}
+}
+
+// On registers fn for the given event types, like IssueCreated or SprintStarted.
+func (h *Handler) On(fn HandlerFunc, eventTypes ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.handlers == nil {
+		h.handlers = make(map[string]HandlerFunc)
+	}
+	for _, eventType := range eventTypes {
+		h.handlers[eventType] = fn
+	}
+}
+
+// OnIssue registers fn for issue events. Without eventTypes, fn receives all issue events.
+func (h *Handler) OnIssue(fn func(ctx context.Context, event *IssueEvent) error, eventTypes ...string) {
+	if len(eventTypes) == 0 {
+		eventTypes = []string{IssueCreated, IssueUpdated, IssueDeleted}
+	}
+	h.On(func(ctx context.Context, event Event) error {
+		return fn(ctx, event.(*IssueEvent))
+	}, eventTypes...)
+}
+
+// OnComment registers fn for comment events. Without eventTypes, fn receives all comment events.
+func (h *Handler) OnComment(fn func(ctx context.Context, event *CommentEvent) error, eventTypes ...string) {
+	if len(eventTypes) == 0 {
+		eventTypes = []string{CommentCreated, CommentUpdated, CommentDeleted}
+	}
+	h.On(func(ctx context.Context, event Event) error {
+		return fn(ctx, event.(*CommentEvent))
+	}, eventTypes...)
+}
+
+// OnWorklog registers fn for worklog events. Without eventTypes, fn receives all worklog events.
+func (h *Handler) OnWorklog(fn func(ctx context.Context, event *WorklogEvent) error, eventTypes ...string) {
+	if len(eventTypes) == 0 {
+		eventTypes = []string{WorklogCreated, WorklogUpdated, WorklogDeleted}
+	}
+	h.On(func(ctx context.Context, event Event) error {
+		return fn(ctx, event.(*WorklogEvent))
+	}, eventTypes...)
+}
+
+// OnSprint registers fn for sprint events. Without eventTypes, fn receives all sprint events.
+func (h *Handler) OnSprint(fn func(ctx context.Context, event *SprintEvent) error, eventTypes ...string) {
+	if len(eventTypes) == 0 {
+		eventTypes = []string{SprintCreated, SprintUpdated, SprintStarted, SprintClosed, SprintDeleted}
+	}
+	h.On(func(ctx context.Context, event Event) error {
+		return fn(ctx, event.(*SprintEvent))
+	}, eventTypes...)
+}
+
+// OnUser registers fn for user events. Without eventTypes, fn receives all user events.
+func (h *Handler) OnUser(fn func(ctx context.Context, event *UserEvent) error, eventTypes ...string) {
+	if len(eventTypes) == 0 {
+		eventTypes = []string{UserCreated, UserUpdated, UserDeleted}
+	}
+	h.On(func(ctx context.Context, event Event) error {
+		return fn(ctx, event.(*UserEvent))
+	}, eventTypes...)
+}
+
+// OnGroup registers fn for group events. Without eventTypes, fn receives all group events.
+func (h *Handler) OnGroup(fn func(ctx context.Context, event *GroupEvent) error, eventTypes ...string) {
+	if len(eventTypes) == 0 {
+		eventTypes = []string{GroupCreated, GroupDeleted}
+	}
+	h.On(func(ctx context.Context, event Event) error {
+		return fn(ctx, event.(*GroupEvent))
+	}, eventTypes...)
+}
+
+// OnUnhandled registers fn for all events without a registered HandlerFunc.
+func (h *Handler) OnUnhandled(fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fallback = fn
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	maxBodySize := h.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxBodySize
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.fail(w, r, http.StatusRequestEntityTooLarge, err)
+			return
+		}
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if h.Verifier != nil {
+		if err := h.Verifier.Verify(r, body); err != nil {
+			h.fail(w, r, http.StatusUnauthorized, err)
+			return
+		}
+	}
+
+	event, err := Parse(body)
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	h.mu.RLock()
+	fn, ok := h.handlers[event.Type()]
+	if !ok {
+		fn = h.fallback
+	}
+	h.mu.RUnlock()
+
+	if fn != nil {
+		if err := fn(r.Context(), event); err != nil {
+			status := http.StatusInternalServerError
+			if IsPermanent(err) {
+				status = http.StatusUnprocessableEntity
+			}
+			h.fail(w, r, status, err)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.ErrorLog != nil {
+		h.ErrorLog(r, err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+// permanentError marks an error that should not be retried by Jira.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent wraps err to signal that Jira should not retry the delivery of the event.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was wrapped with Permanent.
+func IsPermanent(err error) bool {
+	var perr *permanentError
+	return errors.As(err, &perr)
+}
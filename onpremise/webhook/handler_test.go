@@ -0,0 +1,115 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func serve(h http.Handler, method, body string, header http.Header) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, "/webhook", strings.NewReader(body))
+	for k, v := range header {
+		r.Header[k] = v
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestHandler_Dispatch(t *testing.T) {
+	h := NewHandler(nil)
+
+	var gotIssue, gotSprint string
+	h.OnIssue(func(ctx context.Context, event *IssueEvent) error {
+		gotIssue = event.Issue.Key
+		return nil
+	}, IssueCreated)
+	h.OnSprint(func(ctx context.Context, event *SprintEvent) error {
+		gotSprint = event.Sprint.Name
+		return nil
+	})
+
+	w := serve(h, http.MethodPost, `{"webhookEvent":"jira:issue_created","issue":{"key":"TEST-1"}}`, nil)
+	if w.Code != http.StatusNoContent {
+		t.Errorf("Status: %d, want %d", w.Code, http.StatusNoContent)
+	}
+	if gotIssue != "TEST-1" {
+		t.Errorf("Issue handler not called, got %q", gotIssue)
+	}
+
+	w = serve(h, http.MethodPost, `{"webhookEvent":"sprint_closed","sprint":{"id":1,"name":"Sprint 1"}}`, nil)
+	if w.Code != http.StatusNoContent || gotSprint != "Sprint 1" {
+		t.Errorf("Sprint handler not called, status %d, got %q", w.Code, gotSprint)
+	}
+
+	// Only IssueCreated is registered for issue events
+	gotIssue = ""
+	w = serve(h, http.MethodPost, `{"webhookEvent":"jira:issue_deleted","issue":{"key":"TEST-2"}}`, nil)
+	if w.Code != http.StatusNoContent || gotIssue != "" {
+		t.Errorf("Unexpected dispatch, status %d, got %q", w.Code, gotIssue)
+	}
+}
+
+func TestHandler_Unhandled(t *testing.T) {
+	h := NewHandler(nil)
+
+	var got string
+	h.OnUnhandled(func(ctx context.Context, event Event) error {
+		got = event.Type()
+		return nil
+	})
+
+	serve(h, http.MethodPost, `{"webhookEvent":"board_created"}`, nil)
+	if got != "board_created" {
+		t.Errorf("Fallback handler not called, got %q", got)
+	}
+}
+
+func TestHandler_StatusCodes(t *testing.T) {
+	secret := []byte("secret")
+	h := NewHandler(&HMACVerifier{Secret: secret})
+	h.MaxBodySize = 100
+	h.OnUser(func(ctx context.Context, event *UserEvent) error {
+		switch event.User.Name {
+		case "retry":
+			return errors.New("database unavailable")
+		case "permanent":
+			return Permanent(errors.New("unknown user"))
+		}
+		return nil
+	})
+
+	signed := func(body string) http.Header {
+		return http.Header{"X-Hub-Signature": []string{sign(secret, body)}}
+	}
+	userEvent := func(accountID string) string {
+		return `{"webhookEvent":"user_created","user":{"name":"` + accountID + `"}}`
+	}
+	tooLarge := `{"webhookEvent":"user_created","padding":"` + strings.Repeat("x", 100) + `"}`
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		header http.Header
+		want   int
+	}{
+		{"ok", http.MethodPost, userEvent("ok"), signed(userEvent("ok")), http.StatusNoContent},
+		{"wrong method", http.MethodGet, "", nil, http.StatusMethodNotAllowed},
+		{"unsigned", http.MethodPost, userEvent("ok"), nil, http.StatusUnauthorized},
+		{"too large", http.MethodPost, tooLarge, signed(tooLarge), http.StatusRequestEntityTooLarge},
+		{"malformed", http.MethodPost, `{`, signed(`{`), http.StatusBadRequest},
+		{"retry", http.MethodPost, userEvent("retry"), signed(userEvent("retry")), http.StatusInternalServerError},
+		{"permanent", http.MethodPost, userEvent("permanent"), signed(userEvent("permanent")), http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		w := serve(h, tt.method, tt.body, tt.header)
+		if w.Code != tt.want {
+			t.Errorf("%s: Status %d, want %d", tt.name, w.Code, tt.want)
+		}
+	}
+}
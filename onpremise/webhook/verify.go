@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"net/http"
+	"strings"
+)
+
+// ErrVerification is returned (wrapped) by a Verifier if a request could not be verified.
+var ErrVerification = errors.New("webhook: request verification failed")
+
+// Verifier verifies that a webhook request was sent by Jira.
+type Verifier interface {
+	// Verify checks the request r with the already read body.
+	// It returns an error wrapping ErrVerification if the request is not authentic.
+	Verify(r *http.Request, body []byte) error
+}
+
+// HMACVerifier verifies webhooks registered with a secret.
+// Jira signs the payload with the secret and sends the signature
+// in the X-Hub-Signature header, like "sha256=<hex digest>".
+//
+// Jira docs: https://confluence.atlassian.com/adminjiraserver/managing-webhooks-in-jira-938846912.html
+type HMACVerifier struct {
+	Secret []byte
+}
+
+// Verify implements the Verifier interface.
+func (v *HMACVerifier) Verify(r *http.Request, body []byte) error {
+	header := r.Header.Get("X-Hub-Signature")
+	if header == "" {
+		return fmt.Errorf("%w: missing X-Hub-Signature header", ErrVerification)
+	}
+
+	method, signature, ok := strings.Cut(header, "=")
+	if !ok {
+		return fmt.Errorf("%w: malformed X-Hub-Signature header", ErrVerification)
+	}
+
+	var h func() hash.Hash
+	switch strings.ToLower(method) {
+	case "sha256":
+		h = sha256.New
+	case "sha512":
+		h = sha512.New
+	case "sha1":
+		h = sha1.New
+	default:
+		return fmt.Errorf("%w: unsupported signature method %q", ErrVerification, method)
+	}
+
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("%w: malformed signature", ErrVerification)
+	}
+
+	mac := hmac.New(h, v.Secret)
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return fmt.Errorf("%w: signature mismatch", ErrVerification)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func sign(secret []byte, body string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestHMACVerifier(t *testing.T) {
+	secret := []byte("It's a Secret to Everybody")
+	body := `{"webhookEvent":"jira:issue_created"}`
+	v := &HMACVerifier{Secret: secret}
+
+	tests := []struct {
+		header string
+		valid  bool
+	}{
+		{sign(secret, body), true},
+		{sign([]byte("wrong"), body), false},
+		{"", false},
+		{"sha256", false},
+		{"md5=abcdef", false},
+		{"sha256=not-hex", false},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/webhook", nil)
+		if tt.header != "" {
+			r.Header.Set("X-Hub-Signature", tt.header)
+		}
+		err := v.Verify(r, []byte(body))
+		if tt.valid && err != nil {
+			t.Errorf("Error given for %q: %s", tt.header, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrVerification) {
+			t.Errorf("Expected ErrVerification for %q. Got %v", tt.header, err)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package tenant
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned (wrapped) for requests of a tenant whose circuit breaker is open.
+var ErrCircuitOpen = errors.New("tenant: circuit breaker is open")
+
+// State is the state of a circuit breaker.
+type State int
+
+// Circuit breaker states.
+const (
+	// StateClosed lets all requests pass.
+	StateClosed State = iota
+	// StateOpen rejects all requests.
+	StateOpen
+	// StateHalfOpen lets a single probe request pass to test if the tenant recovered.
+	StateHalfOpen
+)
+
+// String returns the name of the state.
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// BreakerSettings configure the circuit breaker of a tenant.
+type BreakerSettings struct {
+	// FailureThreshold is the number of consecutive failures which open the circuit.
+	// A failure is a transport error or a response with status code 429 or 5xx.
+	// If zero, the circuit breaker is disabled.
+	FailureThreshold int
+
+	// OpenTimeout is the time the circuit stays open before a probe request is let through.
+	// It defaults to 30 seconds.
+	OpenTimeout time.Duration
+}
+
+// breaker is a consecutive failure circuit breaker.
+type breaker struct {
+	settings BreakerSettings
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// allow reports whether a request may be sent.
+func (b *breaker) allow() error {
+	if b.settings.FailureThreshold <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		timeout := b.settings.OpenTimeout
+		if timeout <= 0 {
+			timeout = 30 * time.Second
+		}
+		if time.Since(b.openedAt) < timeout {
+			return ErrCircuitOpen
+		}
+		b.state = StateHalfOpen
+		b.probing = true
+		return nil
+	case StateHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// record records the outcome of a request which was allowed.
+func (b *breaker) record(failed bool) {
+	if b.settings.FailureThreshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !failed {
+		b.state = StateClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.settings.FailureThreshold {
+		b.state = StateOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// release gives back a request which was allowed but has no outcome.
+func (b *breaker) release() {
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
+
+func (b *breaker) currentState() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// failed reports whether a request outcome counts as a failure of the tenant.
+func failed(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
@@ -0,0 +1,410 @@
+// Package tenant manages the Jira clients of many Jira sites ("tenants").
+//
+// A Pool builds the cloud.Client or onpremise.Client of a tenant lazily from its Config.
+// All clients share one connection pool, while each tenant has its own rate limiter and
+// circuit breaker, so a single slow or failing tenant cannot starve the others.
+// Clients which were not used for a while are evicted.
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/conductorone/go-jira/v2/cloud"
+	"github.com/conductorone/go-jira/v2/onpremise"
+)
+
+var (
+	// ErrUnknownTenant is returned (wrapped) by a ConfigSource if there is no tenant with the given ID.
+	ErrUnknownTenant = errors.New("tenant: unknown tenant")
+
+	// ErrDeployment is returned (wrapped) if a client of the wrong deployment type is requested for a tenant.
+	ErrDeployment = errors.New("tenant: deployment type mismatch")
+)
+
+// Deployment is the type of a Jira instance.
+type Deployment string
+
+// Deployment types.
+const (
+	Cloud     Deployment = "cloud"
+	OnPremise Deployment = "onpremise"
+)
+
+// Config is the configuration of a tenant.
+type Config struct {
+	// BaseURL is the URL of the Jira instance.
+	BaseURL string
+
+	// Deployment is the type of the Jira instance. It defaults to Cloud.
+	Deployment Deployment
+
+	// Transport wraps the shared transport of the pool with the authentication of the tenant,
+	// e.g. by setting it as the Transport of a cloud.BasicAuthTransport. Optional.
+	Transport func(shared http.RoundTripper) http.RoundTripper
+
+	// RateLimit is the number of requests per second. If zero, requests are not limited.
+	RateLimit float64
+
+	// Burst is the number of requests which may exceed RateLimit at once. It defaults to 1.
+	Burst int
+
+	// Breaker configures the circuit breaker. If nil, Pool.Breaker is used.
+	Breaker *BreakerSettings
+}
+
+// ConfigSource provides the configuration of tenants.
+// Implementations must be safe for concurrent use.
+type ConfigSource interface {
+	// Config returns the configuration of the tenant with the given ID.
+	// It returns an error wrapping ErrUnknownTenant if there is no such tenant.
+	Config(ctx context.Context, id string) (*Config, error)
+}
+
+// ConfigFunc is an adapter to use an ordinary function as a ConfigSource.
+type ConfigFunc func(ctx context.Context, id string) (*Config, error)
+
+// Config implements the ConfigSource interface.
+func (f ConfigFunc) Config(ctx context.Context, id string) (*Config, error) {
+	return f(ctx, id)
+}
+
+// StaticConfigs is a ConfigSource of a fixed set of tenants, keyed by ID.
+type StaticConfigs map[string]*Config
+
+// Config implements the ConfigSource interface.
+func (s StaticConfigs) Config(ctx context.Context, id string) (*Config, error) {
+	cfg, ok := s[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTenant, id)
+	}
+	return cfg, nil
+}
+
+// Health is a snapshot of the health of a tenant.
+type Health struct {
+	ID    string
+	State State
+
+	// ConsecutiveFailures is the number of failed requests since the last successful one.
+	ConsecutiveFailures int
+
+	Requests int64
+	Failures int64
+
+	// LastError is the error of the last failed request.
+	// Responses with status code 429 or 5xx are reported as *StatusError.
+	LastError   error
+	LastFailure time.Time
+	LastSuccess time.Time
+	LastUsed    time.Time
+}
+
+// StatusError reports a response which counted as failure of a tenant.
+type StatusError struct {
+	StatusCode int
+}
+
+// Error implements the error interface.
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("tenant: request failed with status code %d", e.StatusCode)
+}
+
+// Pool builds and caches the Jira clients of tenants.
+// It is safe for concurrent use.
+type Pool struct {
+	// Configs provides the configuration of tenants.
+	Configs ConfigSource
+
+	// Transport is the transport shared by all tenants.
+	// It defaults to a clone of http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// IdleTimeout is the time after which an unused tenant is evicted by EvictIdle.
+	// It defaults to 30 minutes.
+	IdleTimeout time.Duration
+
+	// Breaker are the default circuit breaker settings of tenants.
+	Breaker BreakerSettings
+
+	once   sync.Once
+	shared http.RoundTripper
+
+	mu      sync.Mutex
+	tenants map[string]*entry
+}
+
+// entry is a tenant of the pool.
+type entry struct {
+	id         string
+	deployment Deployment
+	limiter    *limiter
+	breaker    *breaker
+	cloud      *cloud.Client
+	onpremise  *onpremise.Client
+
+	mu                  sync.Mutex
+	consecutiveFailures int
+	requests, failures  int64
+	lastError           error
+	lastFailure         time.Time
+	lastSuccess         time.Time
+	lastUsed            time.Time
+}
+
+// Cloud returns the client of the Jira Cloud tenant with the given ID.
+func (p *Pool) Cloud(ctx context.Context, id string) (*cloud.Client, error) {
+	e, err := p.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.cloud == nil {
+		return nil, fmt.Errorf("%w: %s is a %s tenant", ErrDeployment, id, e.deployment)
+	}
+	return e.cloud, nil
+}
+
+// OnPremise returns the client of the Jira Server / Data Center tenant with the given ID.
+func (p *Pool) OnPremise(ctx context.Context, id string) (*onpremise.Client, error) {
+	e, err := p.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.onpremise == nil {
+		return nil, fmt.Errorf("%w: %s is a %s tenant", ErrDeployment, id, e.deployment)
+	}
+	return e.onpremise, nil
+}
+
+// get returns the tenant with the given ID, building it if needed.
+func (p *Pool) get(ctx context.Context, id string) (*entry, error) {
+	p.mu.Lock()
+	e, ok := p.tenants[id]
+	p.mu.Unlock()
+	if ok {
+		e.touch()
+		return e, nil
+	}
+
+	if p.Configs == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTenant, id)
+	}
+	cfg, err := p.Configs.Config(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	e, err = p.build(id, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// Another goroutine may have built the tenant in the meantime
+	if existing, ok := p.tenants[id]; ok {
+		existing.touch()
+		return existing, nil
+	}
+	if p.tenants == nil {
+		p.tenants = make(map[string]*entry)
+	}
+	p.tenants[id] = e
+	return e, nil
+}
+
+func (p *Pool) build(id string, cfg *Config) (*entry, error) {
+	settings := p.Breaker
+	if cfg.Breaker != nil {
+		settings = *cfg.Breaker
+	}
+	e := &entry{
+		id:         id,
+		deployment: cfg.Deployment,
+		limiter:    newLimiter(cfg.RateLimit, cfg.Burst),
+		breaker:    &breaker{settings: settings},
+		lastUsed:   time.Now(),
+	}
+	if e.deployment == "" {
+		e.deployment = Cloud
+	}
+
+	var transport http.RoundTripper = p.sharedTransport()
+	if cfg.Transport != nil {
+		transport = cfg.Transport(transport)
+	}
+	httpClient := &http.Client{Transport: &tenantTransport{entry: e, next: transport}}
+
+	var err error
+	switch e.deployment {
+	case Cloud:
+		e.cloud, err = cloud.NewClient(cfg.BaseURL, httpClient)
+	case OnPremise:
+		e.onpremise, err = onpremise.NewClient(cfg.BaseURL, httpClient)
+	default:
+		err = fmt.Errorf("%w: unknown deployment %q", ErrDeployment, e.deployment)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tenant %s: %w", id, err)
+	}
+	return e, nil
+}
+
+func (p *Pool) sharedTransport() http.RoundTripper {
+	p.once.Do(func() {
+		p.shared = p.Transport
+		if p.shared == nil {
+			p.shared = http.DefaultTransport.(*http.Transport).Clone()
+		}
+	})
+	return p.shared
+}
+
+// Health returns the health of the tenant with the given ID.
+// It reports false if the tenant has no client in the pool.
+func (p *Pool) Health(id string) (Health, bool) {
+	p.mu.Lock()
+	e, ok := p.tenants[id]
+	p.mu.Unlock()
+	if !ok {
+		return Health{}, false
+	}
+	return e.health(), true
+}
+
+// HealthAll returns the health of all tenants with a client in the pool.
+func (p *Pool) HealthAll() []Health {
+	p.mu.Lock()
+	entries := make([]*entry, 0, len(p.tenants))
+	for _, e := range p.tenants {
+		entries = append(entries, e)
+	}
+	p.mu.Unlock()
+
+	health := make([]Health, 0, len(entries))
+	for _, e := range entries {
+		health = append(health, e.health())
+	}
+	return health
+}
+
+// Evict removes the client of the tenant with the given ID, e.g. after its configuration changed.
+// The next request for the tenant builds a new client.
+func (p *Pool) Evict(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.tenants, id)
+}
+
+// EvictIdle removes the clients of all tenants which were not used for IdleTimeout
+// and returns the number of evicted tenants.
+func (p *Pool) EvictIdle() int {
+	timeout := p.IdleTimeout
+	if timeout <= 0 {
+		timeout = 30 * time.Minute
+	}
+	deadline := time.Now().Add(-timeout)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n := 0
+	for id, e := range p.tenants {
+		e.mu.Lock()
+		idle := e.lastUsed.Before(deadline)
+		e.mu.Unlock()
+		if idle {
+			delete(p.tenants, id)
+			n++
+		}
+	}
+	return n
+}
+
+// Run calls EvictIdle every interval until ctx is done.
+func (p *Pool) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			p.EvictIdle()
+		}
+	}
+}
+
+func (e *entry) touch() {
+	e.mu.Lock()
+	e.lastUsed = time.Now()
+	e.mu.Unlock()
+}
+
+func (e *entry) record(resp *http.Response, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	e.requests++
+	e.lastUsed = now
+	if !failed(resp, err) {
+		e.consecutiveFailures = 0
+		e.lastSuccess = now
+		return
+	}
+
+	e.failures++
+	e.consecutiveFailures++
+	e.lastFailure = now
+	if err == nil {
+		err = &StatusError{StatusCode: resp.StatusCode}
+	}
+	e.lastError = err
+}
+
+func (e *entry) health() Health {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return Health{
+		ID:                  e.id,
+		State:               e.breaker.currentState(),
+		ConsecutiveFailures: e.consecutiveFailures,
+		Requests:            e.requests,
+		Failures:            e.failures,
+		LastError:           e.lastError,
+		LastFailure:         e.lastFailure,
+		LastSuccess:         e.lastSuccess,
+		LastUsed:            e.lastUsed,
+	}
+}
+
+// tenantTransport applies the rate limiter and circuit breaker of a tenant.
+type tenantTransport struct {
+	entry *entry
+	next  http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *tenantTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.entry.breaker.allow(); err != nil {
+		return nil, fmt.Errorf("tenant %s: %w", t.entry.id, err)
+	}
+	if err := t.entry.limiter.wait(req.Context()); err != nil {
+		// The request was never sent, this is no failure of the tenant
+		t.entry.breaker.release()
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil && req.Context().Err() != nil {
+		// Canceled by the caller
+		t.entry.breaker.release()
+		return resp, err
+	}
+	t.entry.breaker.record(failed(resp, err))
+	t.entry.record(resp, err)
+	return resp, err
+}
@@ -0,0 +1,187 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/conductorone/go-jira/v2/cloud"
+)
+
+func TestPool_Cloud(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, _ := r.BasicAuth(); u != "user" || p != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"accountId":"1"}`))
+	}))
+	defer server.Close()
+
+	var builds int32
+	pool := &Pool{
+		Configs: ConfigFunc(func(ctx context.Context, id string) (*Config, error) {
+			atomic.AddInt32(&builds, 1)
+			return &Config{
+				BaseURL: server.URL,
+				Transport: func(shared http.RoundTripper) http.RoundTripper {
+					return &cloud.BasicAuthTransport{Username: "user", APIToken: "token", Transport: shared}
+				},
+			}, nil
+		}),
+	}
+
+	for i := 0; i < 3; i++ {
+		client, err := pool.Cloud(context.Background(), "acme")
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if _, _, err := client.User.GetCurrentUser(context.Background()); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+	if builds != 1 {
+		t.Errorf("Expected the client to be built once. Got %d", builds)
+	}
+
+	health, ok := pool.Health("acme")
+	if !ok || health.Requests != 3 || health.Failures != 0 || health.State != StateClosed {
+		t.Errorf("Unexpected health: %+v", health)
+	}
+
+	if _, err := pool.OnPremise(context.Background(), "acme"); !errors.Is(err, ErrDeployment) {
+		t.Errorf("Expected ErrDeployment. Got %v", err)
+	}
+}
+
+func TestPool_UnknownTenant(t *testing.T) {
+	pool := &Pool{Configs: StaticConfigs{"acme": {BaseURL: "https://acme.atlassian.net"}}}
+	if _, err := pool.Cloud(context.Background(), "unknown"); !errors.Is(err, ErrUnknownTenant) {
+		t.Errorf("Expected ErrUnknownTenant. Got %v", err)
+	}
+	if _, ok := pool.Health("unknown"); ok {
+		t.Errorf("Expected no health for unknown tenant")
+	}
+}
+
+func TestPool_CircuitBreaker(t *testing.T) {
+	var badRequests, goodRequests int32
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&badRequests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer bad.Close()
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&goodRequests, 1)
+		w.Write([]byte(`{"name":"admin"}`))
+	}))
+	defer good.Close()
+
+	pool := &Pool{
+		Configs: StaticConfigs{
+			"bad":  {BaseURL: bad.URL, Deployment: OnPremise},
+			"good": {BaseURL: good.URL, Deployment: OnPremise},
+		},
+		Breaker: BreakerSettings{FailureThreshold: 2, OpenTimeout: 50 * time.Millisecond},
+	}
+	ctx := context.Background()
+
+	badClient, _ := pool.OnPremise(ctx, "bad")
+	goodClient, _ := pool.OnPremise(ctx, "good")
+	for i := 0; i < 5; i++ {
+		_, _, err := badClient.User.GetSelf(ctx)
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+		if i >= 2 && !errors.Is(err, ErrCircuitOpen) {
+			t.Errorf("Expected ErrCircuitOpen. Got %v", err)
+		}
+		if _, _, err := goodClient.User.GetSelf(ctx); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+	}
+	if badRequests != 2 || goodRequests != 5 {
+		t.Errorf("Unexpected requests: bad %d, good %d", badRequests, goodRequests)
+	}
+
+	health, _ := pool.Health("bad")
+	if health.State != StateOpen || health.ConsecutiveFailures != 2 {
+		t.Errorf("Unexpected health: %+v", health)
+	}
+	var statusErr *StatusError
+	if !errors.As(health.LastError, &statusErr) || statusErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Unexpected last error: %v", health.LastError)
+	}
+
+	// After the timeout, a probe is let through and fails again
+	time.Sleep(60 * time.Millisecond)
+	badClient.User.GetSelf(ctx)
+	if badRequests != 3 {
+		t.Errorf("Expected a probe request. Got %d requests", badRequests)
+	}
+	if health, _ := pool.Health("bad"); health.State != StateOpen {
+		t.Errorf("Expected the circuit to open again. Got %s", health.State)
+	}
+}
+
+func TestPool_RateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	pool := &Pool{Configs: StaticConfigs{"acme": {BaseURL: server.URL, RateLimit: 20, Burst: 1}}}
+	client, _ := pool.Cloud(context.Background(), "acme")
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		req, _ := client.NewRequest(context.Background(), http.MethodGet, "rest/api/3/myself", nil)
+		if _, err := client.Do(req, nil); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected requests to be rate limited. Took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := client.NewRequest(ctx, http.MethodGet, "rest/api/3/myself", nil)
+	if _, err := client.Do(req, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled. Got %v", err)
+	}
+	if health, _ := pool.Health("acme"); health.Failures != 0 {
+		t.Errorf("Canceled requests must not count as failures. Got %d", health.Failures)
+	}
+}
+
+func TestPool_EvictIdle(t *testing.T) {
+	pool := &Pool{
+		Configs: StaticConfigs{
+			"acme":   {BaseURL: "https://acme.atlassian.net"},
+			"globex": {BaseURL: "https://jira.globex.com", Deployment: OnPremise},
+		},
+		IdleTimeout: 20 * time.Millisecond,
+	}
+	ctx := context.Background()
+
+	first, _ := pool.Cloud(ctx, "acme")
+	pool.OnPremise(ctx, "globex")
+	time.Sleep(30 * time.Millisecond)
+	pool.OnPremise(ctx, "globex")
+
+	if n := pool.EvictIdle(); n != 1 {
+		t.Errorf("Expected 1 evicted tenant. Got %d", n)
+	}
+	if len(pool.HealthAll()) != 1 {
+		t.Errorf("Expected 1 tenant left")
+	}
+	second, _ := pool.Cloud(ctx, "acme")
+	if first == second {
+		t.Errorf("Expected a new client after eviction")
+	}
+}